@@ -0,0 +1,128 @@
+// Command dailytemperatures prints the daily-temperatures answer for
+// integer readings taken from the files named on the command line, or from
+// standard input when there are none.
+//
+// Usage:
+//
+//	dailytemperatures [-format json|csv|space] [file ...]
+//
+// Readings are separated by whitespace and/or commas. Several files are read
+// in order as one series, like cat. Without any readings the usage message
+// is printed instead.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/lqjxiaoqiu/lqjxiaoqiu/GoSpace/monostack"
+)
+
+var errNoInput = errors.New("no input")
+
+func main() {
+	var stdin io.Reader = os.Stdin
+	if fi, err := os.Stdin.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+		stdin = nil // an interactive terminal is not input
+	}
+	os.Exit(run(os.Args[1:], stdin, os.Stdout, os.Stderr))
+}
+
+// run is the whole command: it parses args, reads readings from the named
+// files or from stdin (nil meaning none), and writes the answer to stdout.
+// It returns the process exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("dailytemperatures", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "space", "output `format`: json, csv or space")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: dailytemperatures [-format json|csv|space] [file ...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	switch *format {
+	case "json", "csv", "space":
+	default:
+		fmt.Fprintf(stderr, "dailytemperatures: unknown format %q\n", *format)
+		fs.Usage()
+		return 2
+	}
+
+	ans, err := compute(fs.Args(), stdin)
+	if errors.Is(err, errNoInput) {
+		fs.Usage()
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "dailytemperatures: %v\n", err)
+		return 1
+	}
+	if err := write(stdout, *format, ans); err != nil {
+		fmt.Fprintf(stderr, "dailytemperatures: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// compute reads the series from files, or from stdin when files is empty,
+// and returns its answer. It returns errNoInput if there are no readings.
+func compute(files []string, stdin io.Reader) ([]int, error) {
+	var readers []io.Reader
+	for i, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if i > 0 {
+			// Keep the last reading of one file from running into the
+			// first reading of the next.
+			readers = append(readers, strings.NewReader("\n"))
+		}
+		readers = append(readers, f)
+	}
+	if len(files) == 0 {
+		if stdin == nil {
+			return nil, errNoInput
+		}
+		readers = append(readers, stdin)
+	}
+	ans, err := monostack.DailyTemperaturesFromReader(io.MultiReader(readers...))
+	if err != nil {
+		return nil, err
+	}
+	if len(ans) == 0 {
+		return nil, errNoInput
+	}
+	return ans, nil
+}
+
+// write prints ans to w in the given format.
+func write(w io.Writer, format string, ans []int) error {
+	if format == "json" {
+		b, err := json.Marshal(ans)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	}
+	sep := " "
+	if format == "csv" {
+		sep = ","
+	}
+	fields := make([]string, len(ans))
+	for i, v := range ans {
+		fields[i] = strconv.Itoa(v)
+	}
+	_, err := fmt.Fprintln(w, strings.Join(fields, sep))
+	return err
+}
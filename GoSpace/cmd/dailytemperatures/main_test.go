@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleInput = "73 74 75 71\n69 72 76 73\n"
+
+func TestRunFormats(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{nil, "1 1 4 2 1 1 0 0\n"},
+		{[]string{"-format", "space"}, "1 1 4 2 1 1 0 0\n"},
+		{[]string{"-format", "csv"}, "1,1,4,2,1,1,0,0\n"},
+		{[]string{"-format", "json"}, "[1,1,4,2,1,1,0,0]\n"},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		code := run(tt.args, strings.NewReader(sampleInput), &stdout, &stderr)
+		if code != 0 {
+			t.Errorf("run(%q) = %d, stderr %q", tt.args, code, stderr.String())
+		}
+		if stdout.String() != tt.want {
+			t.Errorf("run(%q) printed %q, want %q", tt.args, stdout.String(), tt.want)
+		}
+	}
+}
+
+func TestRunFiles(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.txt")
+	b := filepath.Join(dir, "b.txt")
+	// No trailing newline in a: its last reading must not merge with b's first.
+	if err := os.WriteFile(a, []byte("73,74,75"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(b, []byte("71 69 72 76 73"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-format", "csv", a, b}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("run = %d, stderr %q", code, stderr.String())
+	}
+	if want := "1,1,4,2,1,1,0,0\n"; stdout.String() != want {
+		t.Errorf("run printed %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		stdin io.Reader
+	}{
+		{"empty stdin", nil, strings.NewReader("")},
+		{"blank stdin", nil, strings.NewReader(" \n\t\n")},
+		{"no stdin", nil, nil},
+		{"unknown format", []string{"-format", "xml"}, strings.NewReader(sampleInput)},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		if code := run(tt.args, tt.stdin, &stdout, &stderr); code != 2 {
+			t.Errorf("%s: run = %d, want 2", tt.name, code)
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("%s: printed %q, want nothing", tt.name, stdout.String())
+		}
+		if !strings.Contains(stderr.String(), "usage: dailytemperatures") {
+			t.Errorf("%s: stderr %q lacks the usage message", tt.name, stderr.String())
+		}
+	}
+}
+
+func TestRunMalformed(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run(nil, strings.NewReader("73 x"), &stdout, &stderr); code != 1 {
+		t.Errorf("run = %d, want 1", code)
+	}
+	if !strings.Contains(stderr.String(), `"x"`) {
+		t.Errorf("stderr %q does not name the bad token", stderr.String())
+	}
+}
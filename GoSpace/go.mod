@@ -0,0 +1,3 @@
+module github.com/lqjxiaoqiu/lqjxiaoqiu/GoSpace
+
+go 1.23
@@ -0,0 +1,19 @@
+// Package reference holds deliberately simple implementations of the
+// monostack algorithms, for checking the optimized versions against.
+package reference
+
+// DailyTemperatures is the O(n²) brute force of monostack.DailyTemperatures:
+// for each day it walks forward to the first strictly warmer day. It must
+// agree with the stack version on every input.
+func DailyTemperatures(num []int) []int {
+	ans := make([]int, len(num))
+	for i := range num {
+		for j := i + 1; j < len(num); j++ {
+			if num[j] > num[i] {
+				ans[i] = j - i
+				break
+			}
+		}
+	}
+	return ans
+}
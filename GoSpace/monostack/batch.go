@@ -0,0 +1,50 @@
+package monostack
+
+import "sync"
+
+// DailyTemperaturesBatch returns the DailyTemperatures answer of every
+// series, in the same order.
+func DailyTemperaturesBatch(series [][]int) [][]int {
+	out := make([][]int, len(series))
+	for i, num := range series {
+		out[i] = DailyTemperatures(num)
+	}
+	return out
+}
+
+// DailyTemperaturesGrid returns, for each cell of grid, the distance to the
+// next warmer cell to its right in the same row, or 0 if there is none.
+// Rows are independent and may have different lengths; each output row has
+// the length of its input row.
+func DailyTemperaturesGrid(grid [][]int) [][]int {
+	return DailyTemperaturesBatch(grid)
+}
+
+// DailyTemperaturesBatchParallel is like DailyTemperaturesBatch but spreads
+// the series over a pool of workers goroutines. Output order matches
+// series regardless of which worker finishes first. With workers <= 1 it
+// runs sequentially on the calling goroutine.
+func DailyTemperaturesBatchParallel(series [][]int, workers int) [][]int {
+	if workers <= 1 || len(series) <= 1 {
+		return DailyTemperaturesBatch(series)
+	}
+	workers = min(workers, len(series))
+	out := make([][]int, len(series))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				out[i] = DailyTemperatures(series[i])
+			}
+		}()
+	}
+	for i := range series {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return out
+}
@@ -0,0 +1,69 @@
+package monostack
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesBatch(t *testing.T) {
+	series := [][]int{sampleWeek, nil, {80, 70, 90}}
+	got := DailyTemperaturesBatch(series)
+	if len(got) != len(series) {
+		t.Fatalf("DailyTemperaturesBatch returned %d results, want %d", len(got), len(series))
+	}
+	for i, num := range series {
+		if want := DailyTemperatures(num); !slices.Equal(got[i], want) {
+			t.Errorf("result %d = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+// TestDailyTemperaturesBatchParallel is meant to be run with -race as well.
+func TestDailyTemperaturesBatchParallel(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	series := make([][]int, 200)
+	for i := range series {
+		num := make([]int, rng.Intn(300))
+		for j := range num {
+			num[j] = 30 + rng.Intn(71)
+		}
+		series[i] = num
+	}
+	want := DailyTemperaturesBatch(series)
+	for _, workers := range []int{0, 1, 2, 8, 1000} {
+		got := DailyTemperaturesBatchParallel(series, workers)
+		if !slices.EqualFunc(got, want, slices.Equal[[]int]) {
+			t.Errorf("DailyTemperaturesBatchParallel(series, %d) differs from the sequential result", workers)
+		}
+	}
+}
+
+func TestDailyTemperaturesGrid(t *testing.T) {
+	tests := []struct {
+		name       string
+		grid, want [][]int
+	}{
+		{
+			"3x3",
+			[][]int{{1, 2, 3}, {3, 2, 1}, {2, 1, 3}},
+			[][]int{{1, 1, 0}, {0, 0, 0}, {2, 1, 0}},
+		},
+		{
+			"ragged",
+			[][]int{{5}, nil, {1, 2, 0, 4}, {}},
+			[][]int{{0}, {}, {1, 2, 1, 0}, {}},
+		},
+	}
+	for _, tt := range tests {
+		got := DailyTemperaturesGrid(tt.grid)
+		if !slices.EqualFunc(got, tt.want, slices.Equal[[]int]) {
+			t.Errorf("%s: DailyTemperaturesGrid(%v) = %v, want %v", tt.name, tt.grid, got, tt.want)
+		}
+		for i := range got {
+			if len(got[i]) != len(tt.grid[i]) {
+				t.Errorf("%s: row %d has length %d, want %d", tt.name, i, len(got[i]), len(tt.grid[i]))
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package monostack
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"slices"
+	"sync"
+)
+
+// Cache memoizes DailyTemperatures answers by input. Inputs are hashed with
+// FNV-1a over their bytes and the stored input is compared before a cached
+// answer is used, so colliding inputs never share an answer. Entries are
+// never evicted.
+//
+// A Cache is safe for concurrent use. The zero value is ready to use.
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[uint64][]cacheEntry
+
+	// hash overrides hashTemps; it lets collisions be forced deliberately.
+	hash func(num []int) uint64
+}
+
+// cacheEntry is one memoized input and its answer.
+type cacheEntry struct {
+	num []int
+	ans []int
+}
+
+// Get returns DailyTemperatures(num), computing and storing it on a miss.
+// The returned slice is the caller's own copy; num is copied when stored, so
+// either may be modified afterwards.
+func (c *Cache) Get(num []int) []int {
+	h := c.hashOf(num)
+	c.mu.RLock()
+	ans, ok := c.lookup(h, num)
+	c.mu.RUnlock()
+	if ok {
+		return slices.Clone(ans)
+	}
+
+	ans = DailyTemperatures(num)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.lookup(h, num); !ok {
+		if c.entries == nil {
+			c.entries = make(map[uint64][]cacheEntry)
+		}
+		c.entries[h] = append(c.entries[h], cacheEntry{num: slices.Clone(num), ans: slices.Clone(ans)})
+	}
+	return ans
+}
+
+// lookup finds the answer stored for num under hash h. c.mu must be held.
+func (c *Cache) lookup(h uint64, num []int) ([]int, bool) {
+	for _, e := range c.entries[h] {
+		if slices.Equal(e.num, num) {
+			return e.ans, true
+		}
+	}
+	return nil, false
+}
+
+func (c *Cache) hashOf(num []int) uint64 {
+	if c.hash != nil {
+		return c.hash(num)
+	}
+	return hashTemps(num)
+}
+
+// hashTemps is the FNV-1a hash of num's elements as little-endian 64-bit
+// integers.
+func hashTemps(num []int) uint64 {
+	f := fnv.New64a()
+	var buf [8]byte
+	for _, v := range num {
+		binary.LittleEndian.PutUint64(buf[:], uint64(v))
+		f.Write(buf[:])
+	}
+	return f.Sum64()
+}
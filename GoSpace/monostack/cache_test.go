@@ -0,0 +1,63 @@
+package monostack
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestCacheCollision(t *testing.T) {
+	c := &Cache{hash: func([]int) uint64 { return 42 }}
+	a, b := []int{1, 2}, []int{2, 1}
+	if got, want := c.Get(a), []int{1, 0}; !slices.Equal(got, want) {
+		t.Errorf("Get(%v) = %v, want %v", a, got, want)
+	}
+	if got, want := c.Get(b), []int{0, 0}; !slices.Equal(got, want) {
+		t.Errorf("Get(%v) after a colliding input = %v, want %v", b, got, want)
+	}
+	if got, want := c.Get(a), []int{1, 0}; !slices.Equal(got, want) {
+		t.Errorf("Get(%v) again = %v, want %v", a, got, want)
+	}
+	if n := len(c.entries[42]); n != 2 {
+		t.Errorf("bucket holds %d entries, want 2", n)
+	}
+}
+
+func TestCacheCopies(t *testing.T) {
+	var c Cache
+	num := []int{70, 80}
+	got := c.Get(num)
+	got[0] = 99
+	num[0] = 90
+	if got := c.Get([]int{70, 80}); !slices.Equal(got, []int{1, 0}) {
+		t.Errorf("cached answer changed to %v after the caller modified its slices", got)
+	}
+}
+
+// TestCacheConcurrent is meant to be run with -race as well.
+func TestCacheConcurrent(t *testing.T) {
+	var c Cache
+	inputs := [][]int{sampleWeek, {90, 80, 70}, {1, 2, 3}, randomTemps(100)}
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for k := 0; k < 200; k++ {
+				num := inputs[(g+k)%len(inputs)]
+				if got, want := c.Get(num), DailyTemperatures(num); !slices.Equal(got, want) {
+					t.Errorf("Get returned %v, want %v", got, want)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	n := 0
+	for _, bucket := range c.entries {
+		n += len(bucket)
+	}
+	if n != len(inputs) {
+		t.Errorf("cache holds %d entries, want %d", n, len(inputs))
+	}
+}
@@ -0,0 +1,23 @@
+package monostack
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxInputLen is the longest input DailyTemperaturesChecked accepts. At
+// half of math.MaxInt, every index, every distance between two indices and
+// their sum stay representable in the platform's int, 32-bit included. It
+// is a variable so tests can lower it to exercise the error path.
+var maxInputLen = math.MaxInt / 2
+
+// DailyTemperaturesChecked is DailyTemperatures with an explicit
+// precondition: len(num) must not exceed half of the platform's
+// math.MaxInt. Longer input returns an error wrapping ErrInputTooLarge
+// instead of risking overflow in index arithmetic.
+func DailyTemperaturesChecked(num []int) ([]int, error) {
+	if len(num) > maxInputLen {
+		return nil, fmt.Errorf("%w: %d elements, limit %d", ErrInputTooLarge, len(num), maxInputLen)
+	}
+	return DailyTemperatures(num), nil
+}
@@ -0,0 +1,27 @@
+package monostack
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesChecked(t *testing.T) {
+	got, err := DailyTemperaturesChecked(sampleWeek)
+	if err != nil || !slices.Equal(got, DailyTemperatures(sampleWeek)) {
+		t.Errorf("DailyTemperaturesChecked(sampleWeek) = %v, %v", got, err)
+	}
+}
+
+func TestDailyTemperaturesCheckedTooLarge(t *testing.T) {
+	defer func(n int) { maxInputLen = n }(maxInputLen)
+	maxInputLen = len(sampleWeek) - 1
+
+	got, err := DailyTemperaturesChecked(sampleWeek)
+	if !errors.Is(err, ErrInputTooLarge) || got != nil {
+		t.Errorf("DailyTemperaturesChecked over the limit = %v, %v; want nil and ErrInputTooLarge", got, err)
+	}
+	if _, err := DailyTemperaturesChecked(sampleWeek[:maxInputLen]); err != nil {
+		t.Errorf("DailyTemperaturesChecked at the limit: %v", err)
+	}
+}
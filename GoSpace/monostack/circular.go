@@ -0,0 +1,25 @@
+package monostack
+
+// DailyTemperaturesCircular is like DailyTemperatures but treats num as
+// circular, so the last days may find a warmer day by wrapping around to the
+// start. Distances are measured forward with wraparound; a day gets 0 only
+// when no day anywhere in the cycle is warmer.
+//
+// The scan walks the indices twice (2n steps, taken modulo n) and only
+// pushes during the first lap, so it stays O(n).
+func DailyTemperaturesCircular(num []int) []int {
+	n := len(num)
+	ans := make([]int, n)
+	stack := NewMonoStack[int](n)
+	for k := 0; k < 2*n; k++ {
+		i := k % n
+		for !stack.Empty() && num[i] > num[stack.Peek()] {
+			top := stack.Pop()
+			ans[top] = (i - top + n) % n
+		}
+		if k < n {
+			stack.Push(i)
+		}
+	}
+	return ans
+}
@@ -0,0 +1,25 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesCircular(t *testing.T) {
+	tests := []struct {
+		num  []int
+		want []int
+	}{
+		{nil, []int{}},
+		{[]int{70}, []int{0}},
+		// Days 1 and 2 can only find a warmer day by wrapping to day 0.
+		{[]int{75, 74, 73}, []int{0, 2, 1}},
+		{[]int{73, 74, 75, 71, 69, 72, 76, 73}, []int{1, 1, 4, 2, 1, 1, 0, 2}},
+		{[]int{70, 70, 70}, []int{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		if got := DailyTemperaturesCircular(tt.num); !slices.Equal(got, tt.want) {
+			t.Errorf("DailyTemperaturesCircular(%v) = %v, want %v", tt.num, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package monostack
+
+// Integer is the set of integer types DailyTemperatures accepts, matching
+// golang.org/x/exp/constraints.Integer. Only comparisons are made on the
+// values, so no width is at risk of overflow.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
@@ -0,0 +1,58 @@
+package monostack
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DailyTemperaturesFromCSV reads a CSV table with a header row from r,
+// parses the integer cells of the named column, and returns those
+// temperatures together with their DailyTemperatures answer. A header with
+// no data rows gives two empty slices.
+//
+// An input without even a header row, or a cell that is not an integer,
+// returns an error wrapping ErrMalformedInput; the latter names the line
+// and cell. A header without column returns one wrapping ErrMissingColumn.
+func DailyTemperaturesFromCSV(r io.Reader, column string) ([]int, []int, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("%w: empty CSV, no header row", ErrMalformedInput)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrMalformedInput, err)
+	}
+	col := -1
+	for i, name := range header {
+		if strings.TrimSpace(name) == column {
+			col = i
+			break
+		}
+	}
+	if col < 0 {
+		return nil, nil, fmt.Errorf("%w: %q", ErrMissingColumn, column)
+	}
+
+	num := []int{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", ErrMalformedInput, err)
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(record[col]))
+		if err != nil {
+			line, _ := cr.FieldPos(col)
+			return nil, nil, fmt.Errorf("%w: line %d: column %q: %q is not an integer",
+				ErrMalformedInput, line, column, record[col])
+		}
+		num = append(num, v)
+	}
+	return num, DailyTemperatures(num), nil
+}
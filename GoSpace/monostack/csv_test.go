@@ -0,0 +1,59 @@
+package monostack
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDailyTemperaturesFromCSV(t *testing.T) {
+	in := "date,temp,humidity\n" +
+		"mon, 73,40\n" +
+		"tue,74,41\n" +
+		"wed,75,39\n" +
+		"thu,71,50\n"
+	temps, ans, err := DailyTemperaturesFromCSV(strings.NewReader(in), "temp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{73, 74, 75, 71}; !slices.Equal(temps, want) {
+		t.Errorf("temperatures = %v, want %v", temps, want)
+	}
+	if want := []int{1, 1, 0, 0}; !slices.Equal(ans, want) {
+		t.Errorf("answer = %v, want %v", ans, want)
+	}
+}
+
+func TestDailyTemperaturesFromCSVHeaderOnly(t *testing.T) {
+	temps, ans, err := DailyTemperaturesFromCSV(strings.NewReader("date,temp\n"), "temp")
+	if err != nil || len(temps) != 0 || len(ans) != 0 {
+		t.Errorf("header only = %v, %v, %v; want two empty slices", temps, ans, err)
+	}
+}
+
+func TestDailyTemperaturesFromCSVErrors(t *testing.T) {
+	tests := []struct {
+		name, in string
+		target   error
+		mention  string
+	}{
+		{"empty file", "", ErrMalformedInput, "empty"},
+		{"missing column", "date,tmp\nmon,73\n", ErrMissingColumn, `"temp"`},
+		{"bad cell", "date,temp\nmon,73\ntue,warm\n", ErrMalformedInput, "line 3"},
+		{"ragged row", "date,temp\nmon\n", ErrMalformedInput, ""},
+	}
+	for _, tt := range tests {
+		temps, ans, err := DailyTemperaturesFromCSV(strings.NewReader(tt.in), "temp")
+		if !errors.Is(err, tt.target) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, tt.target)
+			continue
+		}
+		if temps != nil || ans != nil {
+			t.Errorf("%s: got results %v, %v alongside the error", tt.name, temps, ans)
+		}
+		if !strings.Contains(err.Error(), tt.mention) {
+			t.Errorf("%s: error %q does not mention %s", tt.name, err, tt.mention)
+		}
+	}
+}
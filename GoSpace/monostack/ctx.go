@@ -0,0 +1,33 @@
+package monostack
+
+import "context"
+
+// ctxCheckInterval is how many days DailyTemperaturesCtx scans between
+// checks of its context.
+const ctxCheckInterval = 1 << 16
+
+// DailyTemperaturesCtx is like DailyTemperatures but stops early once ctx is
+// cancelled or its deadline passes. The context is checked every
+// ctxCheckInterval days; on cancellation the partial answer is discarded
+// and ctx.Err() is returned.
+func DailyTemperaturesCtx(ctx context.Context, num []int) ([]int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	ans := make([]int, len(num))
+	stack := getStack()
+	defer putStack(stack)
+	for i, v := range num {
+		if i%ctxCheckInterval == ctxCheckInterval-1 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		for !stack.Empty() && v > num[stack.Peek()] {
+			top := stack.Pop()
+			ans[top] = i - top
+		}
+		stack.Push(i)
+	}
+	return ans, nil
+}
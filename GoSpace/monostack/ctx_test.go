@@ -0,0 +1,58 @@
+package monostack
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// cancelAfterChecks is a context that another goroutine cancels once Err
+// has been consulted checks times, so cancellation lands mid-scan.
+type cancelAfterChecks struct {
+	context.Context
+	cancel context.CancelFunc
+	checks int
+	calls  int
+}
+
+func (c *cancelAfterChecks) Err() error {
+	c.calls++
+	if c.calls == c.checks {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.cancel()
+		}()
+		wg.Wait()
+	}
+	return c.Context.Err()
+}
+
+func TestDailyTemperaturesCtx(t *testing.T) {
+	got, err := DailyTemperaturesCtx(context.Background(), sampleWeek)
+	if err != nil || !slices.Equal(got, DailyTemperatures(sampleWeek)) {
+		t.Errorf("DailyTemperaturesCtx(sampleWeek) = %v, %v", got, err)
+	}
+}
+
+func TestDailyTemperaturesCtxCancelled(t *testing.T) {
+	parent, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Call 1 is the up-front check; call 3 is the second periodic check.
+	ctx := &cancelAfterChecks{Context: parent, cancel: cancel, checks: 3}
+	num := randomTemps(4 * ctxCheckInterval)
+
+	got, err := DailyTemperaturesCtx(ctx, num)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("DailyTemperaturesCtx error = %v, want context.Canceled", err)
+	}
+	if got != nil {
+		t.Errorf("DailyTemperaturesCtx returned %d partial results, want nil", len(got))
+	}
+	if ctx.calls != 3 {
+		t.Errorf("context checked %d times, want cancellation to stop the scan at check 3", ctx.calls)
+	}
+}
@@ -0,0 +1,101 @@
+// Package monostack implements the daily-temperatures problem and related
+// monotonic-stack algorithms.
+package monostack
+
+import "cmp"
+
+// DailyTemperatures returns, for each day i, the number of days until a
+// strictly warmer temperature than num[i]. Days with no warmer day ahead
+// get 0.
+//
+// A nil or empty num yields an empty, non-nil slice, and a single day always
+// yields [0].
+//
+// The scan keeps a stack of indices whose temperatures are decreasing from
+// bottom to top; each new day pops and resolves every colder day below it.
+// Every index is pushed and popped at most once, so the whole pass is O(n).
+//
+// Any integer element type is accepted, so []int32 or []int64 columns can be
+// passed as they are without converting to []int first.
+func DailyTemperatures[T Integer](num []T) []int {
+	return DailyTemperaturesInto(num, nil)
+}
+
+// NextWarmerIndex returns, for each day, the index of the next strictly
+// warmer day, or -1 if there is none. DailyTemperatures is derived from it:
+// its answer for day i is NextWarmerIndex(num)[i]-i, or 0 where the index
+// is -1.
+func NextWarmerIndex(num []int) []int {
+	return NextGreaterFunc(num, warmer[int])
+}
+
+// DailyTemperaturesInto is like DailyTemperatures but writes the answer into
+// dst, overwriting its contents, and returns dst[:len(num)]. If dst does not
+// have enough capacity a new slice is allocated instead, so a short or nil
+// dst is always safe.
+func DailyTemperaturesInto[T Integer](num []T, dst []int) []int {
+	return distancesInto(num, warmer[T], dst)
+}
+
+// DailyTemperaturesWithSentinel is like DailyTemperatures but reports
+// noneValue instead of 0 for days with no warmer day ahead, such as -1 for
+// an explicit marker. Resolved days are the same either way.
+func DailyTemperaturesWithSentinel[T Integer](num []T, noneValue int) []int {
+	ans := nextGreaterInto(num, warmer[T], nil)
+	for i, next := range ans {
+		if next < 0 {
+			ans[i] = noneValue
+		} else {
+			ans[i] = next - i
+		}
+	}
+	return ans
+}
+
+// DailyColderDays is the dual of DailyTemperatures: for each day it returns
+// the number of days until a strictly colder temperature, or 0 if there is
+// none. It equals DailyTemperatures on the negated input; the stack keeps
+// temperatures increasing from bottom to top instead of decreasing.
+func DailyColderDays[T Integer](num []T) []int {
+	return distancesInto(num, colder[T], make([]int, len(num)))
+}
+
+// DailyTemperaturesMode is DailyTemperatures with a choice of what counts as
+// warmer: with inclusive set, an equal temperature also resolves a day, so
+// [70, 70, 70, 71] gives [1, 1, 1, 0] rather than [3, 2, 1, 0].
+func DailyTemperaturesMode(num []int, inclusive bool) []int {
+	if !inclusive {
+		return DailyTemperatures(num)
+	}
+	// NextGreaterFunc asks for a strict ordering, but the internal scan
+	// only needs a comparison that is transitive: a reading that pops every
+	// top it is >= leaves a strictly decreasing stack, each index is still
+	// pushed and popped once, and the index that pops a day is its first
+	// later day with an equal or higher temperature.
+	return distancesInto(num, func(a, b int) bool { return a >= b }, nil)
+}
+
+// warmer is the strict comparison behind DailyTemperatures.
+func warmer[T cmp.Ordered](a, b T) bool { return a > b }
+
+// colder is the strict comparison behind DailyColderDays.
+func colder[T cmp.Ordered](a, b T) bool { return a < b }
+
+// distancesInto runs the next-greater scan with greater and converts the
+// indices to gaps in days, writing into dst when it is large enough.
+func distancesInto[T any](num []T, greater func(a, b T) bool, dst []int) []int {
+	ans := nextGreaterInto(num, greater, dst)
+	for i, next := range ans {
+		ans[i] = distance(i, next)
+	}
+	return ans
+}
+
+// distance converts a next-greater index into a gap in days, mapping the
+// -1 "none" marker to 0.
+func distance(i, next int) int {
+	if next < 0 {
+		return 0
+	}
+	return next - i
+}
@@ -0,0 +1,92 @@
+package monostack
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var benchSizes = []int{1e3, 1e5, 1e6}
+
+// randomTemps returns n temperatures in [30, 100], the range of the problem
+// statement, from a fixed seed so runs are reproducible.
+func randomTemps(n int) []int {
+	rng := rand.New(rand.NewSource(1))
+	num := make([]int, n)
+	for i := range num {
+		num[i] = 30 + rng.Intn(71)
+	}
+	return num
+}
+
+// ascendingTemps returns n strictly increasing temperatures: every day is
+// pushed and popped again by the next one.
+func ascendingTemps(n int) []int {
+	num := make([]int, n)
+	for i := range num {
+		num[i] = i
+	}
+	return num
+}
+
+func BenchmarkDailyTemperatures(b *testing.B) {
+	for _, n := range benchSizes {
+		random, ascending := randomTemps(n), ascendingTemps(n)
+		b.Run(fmt.Sprintf("random/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				DailyTemperatures(random)
+			}
+		})
+		b.Run(fmt.Sprintf("ascending/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				DailyTemperatures(ascending)
+			}
+		})
+	}
+}
+
+func BenchmarkDailyTemperaturesInto(b *testing.B) {
+	for _, n := range benchSizes {
+		random, ascending := randomTemps(n), ascendingTemps(n)
+		b.Run(fmt.Sprintf("random/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			dst := make([]int, n)
+			for i := 0; i < b.N; i++ {
+				dst = DailyTemperaturesInto(random, dst)
+			}
+		})
+		b.Run(fmt.Sprintf("ascending/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			dst := make([]int, n)
+			for i := 0; i < b.N; i++ {
+				dst = DailyTemperaturesInto(ascending, dst)
+			}
+		})
+	}
+}
+
+// BenchmarkDailyTemperaturesInt32 passes []int32 straight through the
+// generic path; it should allocate no more than the []int benchmarks.
+func BenchmarkDailyTemperaturesInt32(b *testing.B) {
+	for _, n := range benchSizes {
+		num := make([]int32, n)
+		for i, v := range randomTemps(n) {
+			num[i] = int32(v)
+		}
+		b.Run(fmt.Sprintf("random/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				DailyTemperatures(num)
+			}
+		})
+		b.Run(fmt.Sprintf("into/random/n=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			dst := make([]int, n)
+			for i := 0; i < b.N; i++ {
+				dst = DailyTemperaturesInto(num, dst)
+			}
+		})
+	}
+}
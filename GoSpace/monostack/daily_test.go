@@ -0,0 +1,162 @@
+package monostack
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperatures(t *testing.T) {
+	tests := []struct {
+		num  []int
+		want []int
+	}{
+		{[]int{70}, []int{0}},
+		{sampleWeek, []int{1, 1, 4, 2, 1, 1, 0, 0}},
+		{[]int{30, 40, 50, 60}, []int{1, 1, 1, 0}},
+		{[]int{30, 60, 90}, []int{1, 1, 0}},
+		{[]int{90, 80, 70}, []int{0, 0, 0}},
+		{[]int{70, 70, 71}, []int{2, 1, 0}},
+	}
+	for _, tt := range tests {
+		if got := DailyTemperatures(tt.num); !slices.Equal(got, tt.want) {
+			t.Errorf("DailyTemperatures(%v) = %v, want %v", tt.num, got, tt.want)
+		}
+	}
+}
+
+func TestEmptyInputReturnsEmptySlice(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func() []int
+	}{
+		{"DailyTemperatures(nil)", func() []int { return DailyTemperatures[int](nil) }},
+		{"DailyTemperatures([]int{})", func() []int { return DailyTemperatures([]int{}) }},
+		{"DailyTemperaturesInto(nil, nil)", func() []int { return DailyTemperaturesInto[int](nil, nil) }},
+		{"DailyTemperaturesWithSentinel([]int{}, -1)", func() []int { return DailyTemperaturesWithSentinel([]int{}, -1) }},
+		{"DailyTemperaturesMode(nil, true)", func() []int { return DailyTemperaturesMode(nil, true) }},
+		{"NextGreaterElement([]int{})", func() []int { return NextGreaterElement([]int{}) }},
+		{"NextWarmerIndex(nil)", func() []int { return NextWarmerIndex(nil) }},
+	}
+	for _, tt := range tests {
+		if got := tt.fn(); got == nil || len(got) != 0 {
+			t.Errorf("%s = %#v, want non-nil empty slice", tt.name, got)
+		}
+	}
+}
+
+func TestDailyTemperaturesInto(t *testing.T) {
+	want := DailyTemperatures(sampleWeek)
+
+	dst := make([]int, len(sampleWeek), 2*len(sampleWeek))
+	for i := range dst {
+		dst[i] = -99
+	}
+	got := DailyTemperaturesInto(sampleWeek, dst)
+	if !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesInto(sampleWeek, dst) = %v, want %v", got, want)
+	}
+	if &got[0] != &dst[0] {
+		t.Error("DailyTemperaturesInto did not reuse a large enough dst")
+	}
+
+	short := make([]int, 3)
+	got = DailyTemperaturesInto(sampleWeek, short)
+	if !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesInto(sampleWeek, short) = %v, want %v", got, want)
+	}
+	if &got[0] == &short[0] {
+		t.Error("DailyTemperaturesInto wrote into a dst that is too short")
+	}
+}
+
+func TestDailyTemperaturesMode(t *testing.T) {
+	tests := []struct {
+		num       []int
+		inclusive bool
+		want      []int
+	}{
+		{[]int{70, 70, 70, 71}, false, []int{3, 2, 1, 0}},
+		{[]int{70, 70, 70, 71}, true, []int{1, 1, 1, 0}},
+		{[]int{72, 70, 72, 70}, false, []int{0, 1, 0, 0}},
+		{[]int{72, 70, 72, 70}, true, []int{2, 1, 0, 0}},
+		{sampleWeek, true, DailyTemperatures(sampleWeek)},
+	}
+	for _, tt := range tests {
+		if got := DailyTemperaturesMode(tt.num, tt.inclusive); !slices.Equal(got, tt.want) {
+			t.Errorf("DailyTemperaturesMode(%v, %v) = %v, want %v", tt.num, tt.inclusive, got, tt.want)
+		}
+	}
+}
+
+func TestNextWarmerIndex(t *testing.T) {
+	if got, want := NextWarmerIndex(sampleWeek), []int{1, 2, 6, 5, 5, 6, -1, -1}; !slices.Equal(got, want) {
+		t.Errorf("NextWarmerIndex(sampleWeek) = %v, want %v", got, want)
+	}
+	for _, num := range [][]int{sampleWeek, {70, 70, 71}, {90, 80, 70}, randomTemps(100)} {
+		idx := NextWarmerIndex(num)
+		dist := DailyTemperatures(num)
+		for i := range num {
+			want := 0
+			if idx[i] != -1 {
+				want = idx[i] - i
+			}
+			if dist[i] != want {
+				t.Errorf("day %d of %v: DailyTemperatures = %d, NextWarmerIndex = %d", i, num, dist[i], idx[i])
+			}
+		}
+	}
+}
+
+func TestDailyTemperaturesIntegerTypes(t *testing.T) {
+	const hi, lo = math.MaxInt32, math.MinInt32
+	want := []int{1, 3, 2, 1, 0, 0}
+	i32 := []int32{lo, hi - 1, lo + 1, lo, hi, hi}
+	if got := DailyTemperatures(i32); !slices.Equal(got, want) {
+		t.Errorf("DailyTemperatures([]int32) = %v, want %v", got, want)
+	}
+	i64 := []int64{lo, hi - 1, lo + 1, lo, hi, hi}
+	if got := DailyTemperatures(i64); !slices.Equal(got, want) {
+		t.Errorf("DailyTemperatures([]int64) = %v, want %v", got, want)
+	}
+	wide := []int64{math.MinInt64, math.MaxInt64, hi}
+	if got := DailyTemperatures(wide); !slices.Equal(got, []int{1, 0, 0}) {
+		t.Errorf("DailyTemperatures(%v) = %v, want [1 0 0]", wide, got)
+	}
+}
+
+func TestDailyTemperaturesWithSentinel(t *testing.T) {
+	for _, num := range [][]int{sampleWeek, {90, 80, 70}, {70, 70, 71}} {
+		def := DailyTemperatures(num)
+		for _, none := range []int{0, -1, math.MinInt} {
+			got := DailyTemperaturesWithSentinel(num, none)
+			for i := range num {
+				want := def[i]
+				if want == 0 {
+					want = none
+				}
+				if got[i] != want {
+					t.Errorf("DailyTemperaturesWithSentinel(%v, %d)[%d] = %d, want %d", num, none, i, got[i], want)
+				}
+			}
+		}
+	}
+	if got, want := DailyTemperaturesWithSentinel(sampleWeek, -1), []int{1, 1, 4, 2, 1, 1, -1, -1}; !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesWithSentinel(sampleWeek, -1) = %v, want %v", got, want)
+	}
+}
+
+func TestDailyColderDays(t *testing.T) {
+	if got, want := DailyColderDays(sampleWeek), []int{3, 2, 1, 1, 0, 0, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("DailyColderDays(sampleWeek) = %v, want %v", got, want)
+	}
+	for _, num := range [][]int{nil, sampleWeek, {70, 70, 69}, {1, 2, 3}, randomTemps(200)} {
+		neg := make([]int, len(num))
+		for i, v := range num {
+			neg[i] = -v
+		}
+		if got, want := DailyColderDays(num), DailyTemperatures(neg); !slices.Equal(got, want) {
+			t.Errorf("DailyColderDays(%v) = %v, want DailyTemperatures(negated) %v", num, got, want)
+		}
+	}
+}
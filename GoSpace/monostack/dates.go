@@ -0,0 +1,47 @@
+package monostack
+
+import (
+	"fmt"
+	"time"
+)
+
+// DailyTemperaturesWithDates is DailyTemperatures for a series with gaps:
+// temps[i] was read on dates[i], and each answer counts calendar days, not
+// positions, to the next strictly warmer reading. A Monday reading followed
+// by a warmer Thursday reading gets 3. Days with no warmer reading get 0.
+//
+// Calendar days are taken in each date's own location, so daylight-saving
+// shifts do not skew the count. The dates must fall on strictly increasing
+// calendar days; they are not sorted on the caller's behalf, since
+// reordering would silently change which readings come "later". Mismatched
+// lengths return an error wrapping ErrLengthMismatch and out-of-order or
+// repeated days one wrapping ErrUnsortedDates.
+func DailyTemperaturesWithDates(temps []int, dates []time.Time) ([]int, error) {
+	if len(temps) != len(dates) {
+		return nil, fmt.Errorf("%w: %d temperatures, %d dates", ErrLengthMismatch, len(temps), len(dates))
+	}
+	days := make([]int, len(dates))
+	for i, d := range dates {
+		days[i] = civilDay(d)
+		if i > 0 && days[i] <= days[i-1] {
+			return nil, fmt.Errorf("%w: date %d (%s) is not after date %d (%s)",
+				ErrUnsortedDates, i, d.Format(time.DateOnly), i-1, dates[i-1].Format(time.DateOnly))
+		}
+	}
+	ans := nextGreaterInto(temps, warmer[int], nil)
+	for i, next := range ans {
+		if next < 0 {
+			ans[i] = 0
+		} else {
+			ans[i] = days[next] - days[i]
+		}
+	}
+	return ans, nil
+}
+
+// civilDay returns the number of days from the Unix epoch to t's calendar
+// date in t's location.
+func civilDay(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
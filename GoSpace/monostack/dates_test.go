@@ -0,0 +1,72 @@
+package monostack
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestDailyTemperaturesWithDates(t *testing.T) {
+	temps := []int{70, 75, 72, 80, 60}
+	dates := []time.Time{
+		date("2026-03-02"), // Monday
+		date("2026-03-05"), // Thursday
+		date("2026-03-06"),
+		date("2026-04-01"),
+		date("2026-04-03"),
+	}
+	got, err := DailyTemperaturesWithDates(temps, dates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{3, 27, 26, 0, 0}; !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesWithDates = %v, want %v", got, want)
+	}
+}
+
+func TestDailyTemperaturesWithDatesDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data unavailable:", err)
+	}
+	// Clocks spring forward on 2026-03-08, so the span is only 47 hours.
+	dates := []time.Time{
+		time.Date(2026, 3, 7, 12, 0, 0, 0, ny),
+		time.Date(2026, 3, 9, 12, 0, 0, 0, ny),
+	}
+	got, err := DailyTemperaturesWithDates([]int{50, 60}, dates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{2, 0}; !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesWithDates across DST = %v, want %v", got, want)
+	}
+}
+
+func TestDailyTemperaturesWithDatesErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		temps  []int
+		dates  []time.Time
+		target error
+	}{
+		{"length mismatch", []int{70, 71}, []time.Time{date("2026-03-02")}, ErrLengthMismatch},
+		{"unsorted", []int{70, 71, 72}, []time.Time{date("2026-03-02"), date("2026-03-04"), date("2026-03-03")}, ErrUnsortedDates},
+		{"same day", []int{70, 71}, []time.Time{date("2026-03-02"), date("2026-03-02").Add(time.Hour)}, ErrUnsortedDates},
+	}
+	for _, tt := range tests {
+		got, err := DailyTemperaturesWithDates(tt.temps, tt.dates)
+		if !errors.Is(err, tt.target) || got != nil {
+			t.Errorf("%s: got %v, %v; want nil and %v", tt.name, got, err, tt.target)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package monostack
+
+import "cmp"
+
+// DequeEntry is a value held by a MonoDeque together with its push index.
+type DequeEntry[T any] struct {
+	Index int // number of values pushed before this one
+	Value T
+}
+
+// MonoDeque is a double-ended queue for monotonic window scans. Every value
+// is tagged with its push index, counting from 0, so window algorithms can
+// tell when the front has slid out of range. PushKeepingDecreasing keeps the
+// values decreasing from front to back; PushBack leaves ordering to the
+// caller.
+//
+// The zero value is an empty deque ready to use.
+type MonoDeque[T cmp.Ordered] struct {
+	items  []DequeEntry[T]
+	pushed int
+}
+
+// PushBack appends v at the back of the deque.
+func (d *MonoDeque[T]) PushBack(v T) {
+	d.items = append(d.items, DequeEntry[T]{Index: d.pushed, Value: v})
+	d.pushed++
+}
+
+// PushKeepingDecreasing evicts every back entry whose value is smaller than
+// v, then appends v with the next push index.
+func (d *MonoDeque[T]) PushKeepingDecreasing(v T) {
+	for len(d.items) > 0 && d.items[len(d.items)-1].Value < v {
+		d.items = d.items[:len(d.items)-1]
+	}
+	d.PushBack(v)
+}
+
+// PopFront removes and returns the front entry. It panics if the deque is
+// empty.
+func (d *MonoDeque[T]) PopFront() DequeEntry[T] {
+	e := d.items[0]
+	d.items = d.items[1:]
+	return e
+}
+
+// PopBack removes and returns the back entry. It panics if the deque is
+// empty.
+func (d *MonoDeque[T]) PopBack() DequeEntry[T] {
+	e := d.items[len(d.items)-1]
+	d.items = d.items[:len(d.items)-1]
+	return e
+}
+
+// Front returns the front entry. It panics if the deque is empty.
+func (d *MonoDeque[T]) Front() DequeEntry[T] {
+	return d.items[0]
+}
+
+// Back returns the back entry. It panics if the deque is empty.
+func (d *MonoDeque[T]) Back() DequeEntry[T] {
+	return d.items[len(d.items)-1]
+}
+
+// Len returns the number of entries in the deque.
+func (d *MonoDeque[T]) Len() int {
+	return len(d.items)
+}
+
+// Empty reports whether the deque has no entries.
+func (d *MonoDeque[T]) Empty() bool {
+	return len(d.items) == 0
+}
@@ -0,0 +1,51 @@
+package monostack
+
+import "testing"
+
+func TestMonoDequePushKeepingDecreasing(t *testing.T) {
+	var d MonoDeque[int]
+	for _, v := range []int{5, 3, 4, 4, 1, 6, 2} {
+		d.PushKeepingDecreasing(v)
+		for i := 1; i < len(d.items); i++ {
+			prev, cur := d.items[i-1], d.items[i]
+			if prev.Value < cur.Value {
+				t.Fatalf("after pushing %d: %v is not non-increasing", v, d.items)
+			}
+			if prev.Index >= cur.Index {
+				t.Fatalf("after pushing %d: indices in %v are not increasing", v, d.items)
+			}
+		}
+		if back := d.Back(); back.Value != v {
+			t.Fatalf("after pushing %d: Back() = %v", v, back)
+		}
+	}
+	// 6 evicted everything before it; 2 stays behind it.
+	want := []DequeEntry[int]{{Index: 5, Value: 6}, {Index: 6, Value: 2}}
+	if d.Len() != len(want) || d.Front() != want[0] || d.Back() != want[1] {
+		t.Errorf("deque = %v, want %v", d.items, want)
+	}
+}
+
+func TestMonoDequeEnds(t *testing.T) {
+	var d MonoDeque[string]
+	d.PushBack("a")
+	d.PushBack("b")
+	d.PushBack("c")
+	if got := d.PopFront(); got != (DequeEntry[string]{Index: 0, Value: "a"}) {
+		t.Errorf("PopFront() = %v", got)
+	}
+	if got := d.PopBack(); got != (DequeEntry[string]{Index: 2, Value: "c"}) {
+		t.Errorf("PopBack() = %v", got)
+	}
+	if got := d.Front(); got != (DequeEntry[string]{Index: 1, Value: "b"}) {
+		t.Errorf("Front() = %v", got)
+	}
+	d.PopFront()
+	if !d.Empty() {
+		t.Errorf("Empty() = false, Len() = %d", d.Len())
+	}
+	d.PushBack("d")
+	if got := d.Front().Index; got != 3 {
+		t.Errorf("push index after draining = %d, want 3", got)
+	}
+}
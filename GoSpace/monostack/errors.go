@@ -0,0 +1,28 @@
+package monostack
+
+import "errors"
+
+// Errors returned, wrapped with details, by functions in this package. Use
+// errors.Is to test for them.
+var (
+	// ErrInvalidWindow reports a window size outside [1, len(input)].
+	ErrInvalidWindow = errors.New("monostack: invalid window size")
+
+	// ErrInvalidK reports a non-positive k.
+	ErrInvalidK = errors.New("monostack: invalid k")
+
+	// ErrMalformedInput reports input that does not parse as temperatures.
+	ErrMalformedInput = errors.New("monostack: malformed input")
+
+	// ErrMissingColumn reports a CSV header without the requested column.
+	ErrMissingColumn = errors.New("monostack: missing column")
+
+	// ErrLengthMismatch reports parallel slices of different lengths.
+	ErrLengthMismatch = errors.New("monostack: length mismatch")
+
+	// ErrUnsortedDates reports dates that are not strictly increasing.
+	ErrUnsortedDates = errors.New("monostack: dates not strictly increasing")
+
+	// ErrInputTooLarge reports input longer than the scans can index safely.
+	ErrInputTooLarge = errors.New("monostack: input too large")
+)
@@ -0,0 +1,33 @@
+package monostack
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    func() error
+		target error
+	}{
+		{"window 0", func() error { _, err := SlidingWindowMax([]int{1, 2}, 0); return err }, ErrInvalidWindow},
+		{"window too large", func() error { _, err := SlidingWindowMax([]int{1, 2}, 3); return err }, ErrInvalidWindow},
+		{"window on empty", func() error { _, err := SlidingWindowMax(nil, 1); return err }, ErrInvalidWindow},
+		{"k 0", func() error { _, err := KthWarmerDay(sampleWeek, 0); return err }, ErrInvalidK},
+		{"k negative", func() error { _, err := KthWarmerDay(sampleWeek, -2); return err }, ErrInvalidK},
+		{"reader token", func() error {
+			_, err := DailyTemperaturesFromReader(strings.NewReader("1 2.5"))
+			return err
+		}, ErrMalformedInput},
+		{"json syntax", func() error { _, err := DailyTemperaturesJSON([]byte("[1,")); return err }, ErrMalformedInput},
+		{"json element", func() error { _, err := DailyTemperaturesJSON([]byte(`[1,"2"]`)); return err }, ErrMalformedInput},
+	}
+	for _, tt := range tests {
+		err := tt.err()
+		if !errors.Is(err, tt.target) {
+			t.Errorf("%s: error %v is not %v", tt.name, err, tt.target)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package monostack
+
+import "math"
+
+// DailyTemperaturesFloat is DailyTemperatures for fractional readings.
+//
+// A NaN reading is never warmer than anything and nothing is warmer than
+// it: it does not resolve earlier days and always gets 0 itself. NaN days
+// are kept off the stack entirely, since a NaN on the stack would block
+// comparisons with the colder days below it.
+func DailyTemperaturesFloat(num []float64) []int {
+	ans := make([]int, len(num))
+	stack := getStack()
+	defer putStack(stack)
+	for i, v := range num {
+		if math.IsNaN(v) {
+			continue
+		}
+		for !stack.Empty() && v > num[stack.Peek()] {
+			top := stack.Pop()
+			ans[top] = i - top
+		}
+		stack.Push(i)
+	}
+	return ans
+}
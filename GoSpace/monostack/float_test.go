@@ -0,0 +1,28 @@
+package monostack
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesFloat(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name string
+		num  []float64
+		want []int
+	}{
+		{"fractional", []float64{21.5, 21.4, 21.6, 21.6, 22}, []int{2, 1, 2, 1, 0}},
+		{"negative", []float64{-0.5, -1.25, -0.25}, []int{2, 1, 0}},
+		// The NaN neither resolves day 0 nor blocks day 2 from doing so.
+		{"nan between", []float64{20, nan, 21}, []int{2, 0, 0}},
+		{"nan first", []float64{nan, 1, 2}, []int{0, 1, 0}},
+		{"all nan", []float64{nan, nan}, []int{0, 0}},
+	}
+	for _, tt := range tests {
+		if got := DailyTemperaturesFloat(tt.num); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: DailyTemperaturesFloat(%v) = %v, want %v", tt.name, tt.num, got, tt.want)
+		}
+	}
+}
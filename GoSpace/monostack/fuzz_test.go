@@ -0,0 +1,29 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lqjxiaoqiu/lqjxiaoqiu/GoSpace/internal/reference"
+)
+
+// FuzzDailyTemperatures checks the stack scan against the brute-force
+// reference. Each fuzz byte is one temperature, read as a signed value, so
+// short inputs are full of ties and negative readings.
+func FuzzDailyTemperatures(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{70, 70, 70, 70})
+	f.Add([]byte{1, 2, 3, 4, 5})
+	f.Add([]byte{5, 4, 3, 2, 1})
+	f.Add([]byte{73, 74, 75, 71, 69, 72, 76, 73})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		num := make([]int, len(data))
+		for i, b := range data {
+			num[i] = int(int8(b))
+		}
+		got := DailyTemperatures(num)
+		if want := reference.DailyTemperatures(num); !slices.Equal(got, want) {
+			t.Fatalf("DailyTemperatures(%v) = %v, reference %v", num, got, want)
+		}
+	})
+}
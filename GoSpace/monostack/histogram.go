@@ -0,0 +1,33 @@
+package monostack
+
+// LargestRectangleArea returns the area of the largest rectangle that fits
+// under the histogram described by heights, where every bar is one unit
+// wide.
+//
+// The scan keeps a stack of bar indices with increasing heights. A lower bar
+// pops every taller bar, and each popped bar is the shortest of the
+// rectangle spanning from the bar below it on the stack to the current one.
+// A trailing height of 0 is processed as a sentinel to flush the stack.
+func LargestRectangleArea(heights []int) int {
+	n := len(heights)
+	best := 0
+	stack := NewMonoStack[int](n)
+	for i := 0; i <= n; i++ {
+		h := 0
+		if i < n {
+			h = heights[i]
+		}
+		for !stack.Empty() && h < heights[stack.Peek()] {
+			top := stack.Pop()
+			left := -1
+			if !stack.Empty() {
+				left = stack.Peek()
+			}
+			if area := heights[top] * (i - left - 1); area > best {
+				best = area
+			}
+		}
+		stack.Push(i)
+	}
+	return best
+}
@@ -0,0 +1,21 @@
+package monostack
+
+import "testing"
+
+func TestLargestRectangleArea(t *testing.T) {
+	tests := []struct {
+		heights []int
+		want    int
+	}{
+		{nil, 0},
+		{[]int{2, 1, 5, 6, 2, 3}, 10},
+		{[]int{1, 2, 3, 4, 5}, 9},
+		{[]int{5, 4, 3, 2, 1}, 9},
+		{[]int{3, 3, 3}, 9},
+	}
+	for _, tt := range tests {
+		if got := LargestRectangleArea(tt.heights); got != tt.want {
+			t.Errorf("LargestRectangleArea(%v) = %d, want %d", tt.heights, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package monostack
+
+// DailyTemperaturesHorizon is like DailyTemperatures but only looks h days
+// ahead: a day whose next warmer day is more than h days later gets 0, as
+// does every day when h < 1.
+//
+// Filtering the DailyTemperatures answer afterwards would give the same
+// result, but the scan here never holds a day past its horizon: pending
+// days sit in a MonoDeque with decreasing temperatures, expired days are
+// dropped from the front as the scan advances, and warmer readings resolve
+// days from the back. Each day enters and leaves once, so it is O(n) time
+// and O(min(n, h)) extra space.
+func DailyTemperaturesHorizon(num []int, h int) []int {
+	ans := make([]int, len(num))
+	if h < 1 {
+		return ans
+	}
+	var pending MonoDeque[int]
+	for i, v := range num {
+		for !pending.Empty() && pending.Front().Index < i-h {
+			pending.PopFront()
+		}
+		for !pending.Empty() && pending.Back().Value < v {
+			day := pending.PopBack().Index
+			ans[day] = i - day
+		}
+		pending.PushBack(v)
+	}
+	return ans
+}
@@ -0,0 +1,26 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesHorizon(t *testing.T) {
+	if got, want := DailyTemperaturesHorizon(sampleWeek, 2), []int{1, 1, 0, 2, 1, 1, 0, 0}; !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesHorizon(sampleWeek, 2) = %v, want %v", got, want)
+	}
+	for _, num := range [][]int{sampleWeek, {70, 70, 70, 71}, randomTemps(300)} {
+		full := DailyTemperatures(num)
+		for _, h := range []int{-1, 0, 1, 2, 3, 10, len(num)} {
+			want := slices.Clone(full)
+			for i := range want {
+				if want[i] > h {
+					want[i] = 0
+				}
+			}
+			if got := DailyTemperaturesHorizon(num, h); !slices.Equal(got, want) {
+				t.Errorf("DailyTemperaturesHorizon(%v, %d) = %v, want %v", num, h, got, want)
+			}
+		}
+	}
+}
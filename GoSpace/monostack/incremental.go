@@ -0,0 +1,37 @@
+package monostack
+
+// Unresolved is what IncrementalTemps.Answer reports for a day that has not
+// seen a warmer day yet.
+const Unresolved = -1
+
+// IncrementalTemps answers daily-temperatures queries on a growing prefix.
+// It runs an OnlineDailyTemperatures and records every resolution, so any
+// day's answer can be read back at any time. Unlike the online type it
+// keeps one answer per reading.
+//
+// The zero value is ready to use.
+type IncrementalTemps struct {
+	online OnlineDailyTemperatures
+	ans    []int
+}
+
+// Extend appends the next reading.
+func (t *IncrementalTemps) Extend(temp int) {
+	t.ans = append(t.ans, Unresolved)
+	for _, r := range t.online.Push(temp) {
+		t.ans[r.Day] = r.Distance
+	}
+}
+
+// Answer returns the distance from day to its next warmer day within the
+// readings so far, or Unresolved if none of them is warmer. An Unresolved
+// day may still be resolved by a later Extend; a distance never changes. It
+// panics if day is not in [0, Len()).
+func (t *IncrementalTemps) Answer(day int) int {
+	return t.ans[day]
+}
+
+// Len returns the number of readings so far.
+func (t *IncrementalTemps) Len() int {
+	return len(t.ans)
+}
@@ -0,0 +1,30 @@
+package monostack
+
+import "testing"
+
+func TestIncrementalTemps(t *testing.T) {
+	var it IncrementalTemps
+	steps := []struct {
+		extend int
+		want   []int // Answer(0..Len()-1) after the extension
+	}{
+		{73, []int{Unresolved}},
+		{74, []int{1, Unresolved}},
+		{75, []int{1, 1, Unresolved}},
+		{71, []int{1, 1, Unresolved, Unresolved}},
+		{69, []int{1, 1, Unresolved, Unresolved, Unresolved}},
+		{72, []int{1, 1, Unresolved, 2, 1, Unresolved}},
+		{76, []int{1, 1, 4, 2, 1, 1, Unresolved}},
+	}
+	for _, step := range steps {
+		it.Extend(step.extend)
+		if it.Len() != len(step.want) {
+			t.Fatalf("after Extend(%d): Len() = %d, want %d", step.extend, it.Len(), len(step.want))
+		}
+		for day, want := range step.want {
+			if got := it.Answer(day); got != want {
+				t.Errorf("after Extend(%d): Answer(%d) = %d, want %d", step.extend, day, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package monostack
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DailyTemperaturesJSON decodes a JSON array of integers from in and returns
+// its DailyTemperatures answer encoded as a JSON array. Invalid JSON, or
+// elements that are not integers, are reported as an error wrapping
+// ErrMalformedInput.
+func DailyTemperaturesJSON(in []byte) ([]byte, error) {
+	var num []int
+	if err := json.Unmarshal(in, &num); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedInput, err)
+	}
+	return json.Marshal(DailyTemperatures(num))
+}
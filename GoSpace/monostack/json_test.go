@@ -0,0 +1,31 @@
+package monostack
+
+import "testing"
+
+func TestDailyTemperaturesJSON(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`[]`, `[]`},
+		{`[73,74,75,71,69,72,76,73]`, `[1,1,4,2,1,1,0,0]`},
+		{` [30, 40] `, `[1,0]`},
+	}
+	for _, tt := range tests {
+		got, err := DailyTemperaturesJSON([]byte(tt.in))
+		if err != nil {
+			t.Errorf("DailyTemperaturesJSON(%s): unexpected error %v", tt.in, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("DailyTemperaturesJSON(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDailyTemperaturesJSONMalformed(t *testing.T) {
+	for _, in := range []string{`[1, 2`, `{"a": 1}`, `[1.5]`, `["73"]`} {
+		if got, err := DailyTemperaturesJSON([]byte(in)); err == nil {
+			t.Errorf("DailyTemperaturesJSON(%s) = %s, want an error", in, got)
+		}
+	}
+}
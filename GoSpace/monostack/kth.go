@@ -0,0 +1,44 @@
+package monostack
+
+import "fmt"
+
+// KthWarmerDay returns, for each day i, the number of days until the k-th
+// later day that is strictly warmer than num[i], or 0 if fewer than k later
+// days are. KthWarmerDay(num, 1) equals DailyTemperatures(num). It returns
+// an error wrapping ErrInvalidK if k < 1.
+//
+// Days wait on one of k decreasing stacks according to how many warmer days
+// they have seen so far. A new reading pops every colder day off each
+// stack; days leaving stack s move, in order, onto stack s+1, and days
+// leaving the last stack are resolved. The stacks are visited from the last
+// to the first so a day is never moved twice by the same reading, and
+// everything moved is colder than what it lands on, which keeps every
+// stack decreasing. Each day moves at most k times: O(n·k) time and O(n+k)
+// space.
+func KthWarmerDay(num []int, k int) ([]int, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidK, k)
+	}
+	ans := make([]int, len(num))
+	stacks := make([][]int, k)
+	for i, v := range num {
+		for s := k - 1; s >= 0; s-- {
+			st := stacks[s]
+			j := len(st)
+			for j > 0 && num[st[j-1]] < v {
+				j--
+			}
+			moved := st[j:]
+			if s == k-1 {
+				for _, d := range moved {
+					ans[d] = i - d
+				}
+			} else {
+				stacks[s+1] = append(stacks[s+1], moved...)
+			}
+			stacks[s] = st[:j]
+		}
+		stacks[0] = append(stacks[0], i)
+	}
+	return ans, nil
+}
@@ -0,0 +1,58 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+// bruteKthWarmerDay finds the k-th warmer day ahead by walking forward.
+func bruteKthWarmerDay(num []int, k int) []int {
+	ans := make([]int, len(num))
+	for i := range num {
+		seen := 0
+		for j := i + 1; j < len(num); j++ {
+			if num[j] > num[i] {
+				if seen++; seen == k {
+					ans[i] = j - i
+					break
+				}
+			}
+		}
+	}
+	return ans
+}
+
+func TestKthWarmerDay(t *testing.T) {
+	got, err := KthWarmerDay(sampleWeek, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{2, 5, 0, 3, 2, 2, 0, 0}; !slices.Equal(got, want) {
+		t.Errorf("KthWarmerDay(sampleWeek, 2) = %v, want %v", got, want)
+	}
+
+	got, _ = KthWarmerDay(sampleWeek, 1)
+	if want := DailyTemperatures(sampleWeek); !slices.Equal(got, want) {
+		t.Errorf("KthWarmerDay(sampleWeek, 1) = %v, want DailyTemperatures %v", got, want)
+	}
+}
+
+func TestKthWarmerDayBrute(t *testing.T) {
+	inputs := [][]int{
+		{},
+		{70, 70, 70, 71, 71, 72},
+		{5, 1, 4, 2, 3, 6, 0, 7},
+		randomTemps(200),
+	}
+	for _, num := range inputs {
+		for k := 1; k <= 4; k++ {
+			got, err := KthWarmerDay(num, k)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := bruteKthWarmerDay(num, k); !slices.Equal(got, want) {
+				t.Errorf("KthWarmerDay(%v, %d) = %v, want %v", num, k, got, want)
+			}
+		}
+	}
+}
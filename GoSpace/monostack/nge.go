@@ -0,0 +1,77 @@
+package monostack
+
+import "cmp"
+
+// NextGreaterElement returns, for each index i, the index of the next
+// element to the right that is strictly greater than xs[i], or -1 if there
+// is none. It uses the same single decreasing-stack pass as
+// DailyTemperatures and works for any ordered type.
+//
+// The constraint is cmp.Ordered, the standard-library equivalent of
+// golang.org/x/exp/constraints.Ordered.
+func NextGreaterElement[T cmp.Ordered](xs []T) []int {
+	return NextGreaterFunc(xs, func(a, b T) bool { return a > b })
+}
+
+// NextGreaterFunc is like NextGreaterElement but orders elements with
+// greater, which reports whether a ranks strictly above b. The stack is
+// popped while greater(current, top) holds.
+//
+// greater must be a strict ordering (irreflexive and transitive); passing
+// the reversed comparison yields the next smaller element instead.
+func NextGreaterFunc[T any](xs []T, greater func(a, b T) bool) []int {
+	return nextGreaterInto(xs, greater, nil)
+}
+
+// nextGreaterInto is NextGreaterFunc writing into dst when it has room for
+// len(xs) elements. Its scratch stack is borrowed from stackPool, so
+// repeated calls do not reallocate it.
+func nextGreaterInto[T any](xs []T, greater func(a, b T) bool, dst []int) []int {
+	ans := grow(dst, len(xs))
+	stack := getStack()
+	defer putStack(stack)
+	for i, v := range xs {
+		ans[i] = -1
+		for !stack.Empty() && greater(v, xs[stack.Peek()]) {
+			ans[stack.Pop()] = i
+		}
+		stack.Push(i)
+	}
+	return ans
+}
+
+// grow returns dst resliced to length n, or a new slice if dst is nil or
+// too small. The result is never nil, even for n == 0.
+func grow(dst []int, n int) []int {
+	if dst == nil || cap(dst) < n {
+		return make([]int, n)
+	}
+	return dst[:n]
+}
+
+// NextGreaterElementMapped answers, for each value in nums1, the first value
+// to its right in nums2 that is greater, or -1 if there is none (LeetCode
+// 496). nums2 is assumed to hold distinct values and nums1 a subset of
+// them; a value of nums1 missing from nums2 also gets -1.
+//
+// One decreasing-stack pass over nums2 builds a value-to-next-greater map,
+// after which every query is a single lookup: O(len(nums1)+len(nums2)).
+func NextGreaterElementMapped(nums1, nums2 []int) []int {
+	next := make(map[int]int, len(nums2))
+	stack := NewMonoStack[int](len(nums2))
+	for _, v := range nums2 {
+		for !stack.Empty() && v > stack.Peek() {
+			next[stack.Pop()] = v
+		}
+		stack.Push(v)
+	}
+	ans := make([]int, len(nums1))
+	for i, v := range nums1 {
+		if g, ok := next[v]; ok {
+			ans[i] = g
+		} else {
+			ans[i] = -1
+		}
+	}
+	return ans
+}
@@ -0,0 +1,69 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNextGreaterElement(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"empty", NextGreaterElement([]int{}), []int{}},
+		{"single", NextGreaterElement([]int{42}), []int{-1}},
+		{"ints", NextGreaterElement([]int{2, 1, 2, 4, 3}), []int{3, 2, 3, -1, -1}},
+		{"floats", NextGreaterElement([]float64{1.5, 0.5, 1.5, 2.25, -3}), []int{3, 2, 3, -1, -1}},
+		{"float ties", NextGreaterElement([]float64{2.5, 2.5, 2.5}), []int{-1, -1, -1}},
+		{"strings", NextGreaterElement([]string{"b", "a", "c", "ab", "b"}), []int{2, 2, -1, 4, -1}},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s: NextGreaterElement = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestNextGreaterFuncStruct(t *testing.T) {
+	type reading struct {
+		Day       string
+		FeelsLike float64
+	}
+	rs := []reading{
+		{"mon", 21.5},
+		{"tue", 19},
+		{"wed", 22},
+		{"thu", 22},
+		{"fri", 18.5},
+	}
+	got := NextGreaterFunc(rs, func(a, b reading) bool { return a.FeelsLike > b.FeelsLike })
+	want := []int{2, 2, -1, -1, -1}
+	if !slices.Equal(got, want) {
+		t.Errorf("NextGreaterFunc(readings) = %v, want %v", got, want)
+	}
+}
+
+func TestNextGreaterFuncReversed(t *testing.T) {
+	xs := []int{5, 3, 4, 1, 2, 2}
+	got := NextGreaterFunc(xs, func(a, b int) bool { return a < b })
+	want := []int{1, 3, 3, -1, -1, -1}
+	if !slices.Equal(got, want) {
+		t.Errorf("NextGreaterFunc(%v, <) = %v, want %v", xs, got, want)
+	}
+}
+
+func TestNextGreaterElementMapped(t *testing.T) {
+	tests := []struct {
+		nums1, nums2, want []int
+	}{
+		{[]int{4, 1, 2}, []int{1, 3, 4, 2}, []int{-1, 3, -1}},
+		{[]int{2, 4}, []int{1, 2, 3, 4}, []int{3, -1}},
+		{[]int{9}, []int{1, 2}, []int{-1}},
+	}
+	for _, tt := range tests {
+		if got := NextGreaterElementMapped(tt.nums1, tt.nums2); !slices.Equal(got, tt.want) {
+			t.Errorf("NextGreaterElementMapped(%v, %v) = %v, want %v", tt.nums1, tt.nums2, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package monostack
+
+// DailyTemperaturesObserve runs the DailyTemperatures scan and calls
+// onResolve once for every day instead of building a slice. Calls come in
+// resolution order: whenever a reading pops colder days off the stack, they
+// are reported nearest day first. When the scan ends, the days left on the
+// stack are reported in increasing day order with distance 0.
+func DailyTemperaturesObserve(num []int, onResolve func(day, distance int)) {
+	stack := getStack()
+	defer putStack(stack)
+	for i, v := range num {
+		for !stack.Empty() && v > num[stack.Peek()] {
+			top := stack.Pop()
+			onResolve(top, i-top)
+		}
+		stack.Push(i)
+	}
+	for _, day := range stack.items {
+		onResolve(day, 0)
+	}
+}
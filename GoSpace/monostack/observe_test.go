@@ -0,0 +1,47 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesObserve(t *testing.T) {
+	for _, num := range [][]int{sampleWeek, randomTemps(200), {90, 80, 70}} {
+		var events []Resolution
+		DailyTemperaturesObserve(num, func(day, distance int) {
+			events = append(events, Resolution{Day: day, Distance: distance})
+		})
+		slices.SortFunc(events, func(a, b Resolution) int { return a.Day - b.Day })
+		got := make([]int, len(events))
+		for i, e := range events {
+			if e.Day != i {
+				t.Fatalf("DailyTemperaturesObserve(%v): sorted callbacks have day %d at position %d", num, e.Day, i)
+			}
+			got[i] = e.Distance
+		}
+		if want := DailyTemperatures(num); !slices.Equal(got, want) {
+			t.Errorf("DailyTemperaturesObserve(%v) sorted = %v, want %v", num, got, want)
+		}
+	}
+}
+
+func TestDailyTemperaturesObserveOrder(t *testing.T) {
+	var got []Resolution
+	DailyTemperaturesObserve(sampleWeek, func(day, distance int) {
+		got = append(got, Resolution{Day: day, Distance: distance})
+	})
+	// Pops come nearest day first; days 6 and 7 are left on the stack.
+	want := []Resolution{
+		{Day: 0, Distance: 1},
+		{Day: 1, Distance: 1},
+		{Day: 4, Distance: 1},
+		{Day: 3, Distance: 2},
+		{Day: 5, Distance: 1},
+		{Day: 2, Distance: 4},
+		{Day: 6, Distance: 0},
+		{Day: 7, Distance: 0},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesObserve(sampleWeek) calls = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,79 @@
+package monostack
+
+// Resolution reports that Day has found its next warmer day, Distance days
+// later.
+type Resolution struct {
+	Day      int
+	Distance int
+}
+
+// pending is a day still waiting on the online stack for a warmer day.
+type pending struct {
+	day  int
+	temp int
+}
+
+// OnlineDailyTemperatures answers the daily-temperatures problem for an
+// append-only feed, one reading at a time. Only the days still waiting for a
+// warmer day are kept, so memory is bounded by the pending stack rather than
+// the length of the feed. The stack still grows with every reading of a
+// feed that keeps cooling; SetMaxPending caps it.
+//
+// The zero value is ready to use.
+type OnlineDailyTemperatures struct {
+	stack      MonoStack[pending]
+	days       int
+	maxPending int // 0 means unlimited
+}
+
+// SetMaxPending caps the number of pending days at n; n <= 0 removes the
+// cap. Whenever a push would leave more than n days pending, the oldest
+// pending day is force-finalized with distance 0 and dropped, even though a
+// later reading might still have been warmer. This trades correctness of
+// those days for bounded memory on adversarial feeds.
+func (o *OnlineDailyTemperatures) SetMaxPending(n int) {
+	o.maxPending = max(n, 0)
+}
+
+// Push appends the next reading and returns the earlier days it resolves,
+// nearest day first. The new reading is day number Len()-1 afterwards. If
+// the pending cap set by SetMaxPending is exceeded, the days force-finalized
+// as a result are appended with distance 0, oldest first.
+func (o *OnlineDailyTemperatures) Push(temp int) (resolved []Resolution) {
+	day := o.days
+	o.days++
+	for !o.stack.Empty() && temp > o.stack.Peek().temp {
+		top := o.stack.Pop()
+		resolved = append(resolved, Resolution{Day: top.day, Distance: day - top.day})
+	}
+	o.stack.Push(pending{day: day, temp: temp})
+	for o.maxPending > 0 && o.stack.Len() > o.maxPending {
+		oldest := o.stack.items[0]
+		o.stack.items = o.stack.items[1:]
+		resolved = append(resolved, Resolution{Day: oldest.day})
+	}
+	return resolved
+}
+
+// Len returns the number of readings pushed so far.
+func (o *OnlineDailyTemperatures) Len() int {
+	return o.days
+}
+
+// Pending returns the number of days still waiting for a warmer day.
+func (o *OnlineDailyTemperatures) Pending() int {
+	return o.stack.Len()
+}
+
+// Finalize ends the feed: every day still pending has no warmer day and
+// therefore the answer 0. It returns those days in increasing order and
+// clears them, so later pushes start a fresh stack while day numbering
+// continues.
+func (o *OnlineDailyTemperatures) Finalize() []int {
+	days := make([]int, o.stack.Len())
+	for i, p := range o.stack.items {
+		days[i] = p.day
+	}
+	o.stack.items = o.stack.items[:0]
+	return days
+}
@@ -0,0 +1,60 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOnlineDailyTemperatures(t *testing.T) {
+	var o OnlineDailyTemperatures
+	ans := make([]int, len(sampleWeek))
+	for _, v := range sampleWeek {
+		for _, r := range o.Push(v) {
+			ans[r.Day] = r.Distance
+		}
+	}
+	if got, want := o.Finalize(), []int{6, 7}; !slices.Equal(got, want) {
+		t.Errorf("Finalize() = %v, want %v", got, want)
+	}
+	if want := DailyTemperatures(sampleWeek); !slices.Equal(ans, want) {
+		t.Errorf("online answers = %v, want %v", ans, want)
+	}
+	if o.Len() != len(sampleWeek) || o.Pending() != 0 {
+		t.Errorf("after Finalize: Len() = %d, Pending() = %d", o.Len(), o.Pending())
+	}
+}
+
+func TestOnlineDailyTemperaturesMaxPending(t *testing.T) {
+	const limit = 3
+	var o OnlineDailyTemperatures
+	o.SetMaxPending(limit)
+	forced := 0
+	for i := 0; i < 100; i++ {
+		// A steadily cooling feed keeps every day pending.
+		for _, r := range o.Push(100 - i) {
+			if r.Distance != 0 {
+				t.Fatalf("Push(%d) resolved day %d with distance %d on a cooling feed", 100-i, r.Day, r.Distance)
+			}
+			if want := forced; r.Day != want {
+				t.Fatalf("force-finalized day %d, want oldest day %d", r.Day, want)
+			}
+			forced++
+		}
+		if o.Pending() > limit {
+			t.Fatalf("after %d pushes: Pending() = %d, cap %d", i+1, o.Pending(), limit)
+		}
+		if c := cap(o.stack.items); c > 4*limit {
+			t.Fatalf("after %d pushes: backing capacity %d grew past the cap", i+1, c)
+		}
+	}
+	if want := 100 - limit; forced != want {
+		t.Errorf("force-finalized %d days, want %d", forced, want)
+	}
+
+	// A warm reading still resolves what is left with real distances.
+	got := o.Push(1000)
+	want := []Resolution{{Day: 99, Distance: 1}, {Day: 98, Distance: 2}, {Day: 97, Distance: 3}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Push(1000) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,38 @@
+package monostack
+
+// PreviousWarmerDays returns, for each day i, how many days back the most
+// recent strictly warmer day was, or 0 if no earlier day is warmer. Equal
+// temperatures do not count as warmer.
+//
+// It mirrors DailyTemperatures with a single right-to-left pass: each day
+// pops and resolves the later, colder days still waiting on the stack.
+func PreviousWarmerDays(num []int) []int {
+	return previousWarmerInto(num, make([]int, len(num)))
+}
+
+// WarmerDaysBoth returns DailyTemperatures(num) and PreviousWarmerDays(num)
+// together, with the same tie rules, from two passes sharing a single
+// allocation.
+func WarmerDaysBoth(num []int) (next, prev []int) {
+	n := len(num)
+	buf := make([]int, 2*n)
+	next = DailyTemperaturesInto(num, buf[:n:n])
+	prev = previousWarmerInto(num, buf[n:])
+	return next, prev
+}
+
+// previousWarmerInto is PreviousWarmerDays writing into dst, which must
+// have length len(num).
+func previousWarmerInto(num []int, dst []int) []int {
+	stack := getStack()
+	defer putStack(stack)
+	for i := len(num) - 1; i >= 0; i-- {
+		dst[i] = 0
+		for !stack.Empty() && num[i] > num[stack.Peek()] {
+			top := stack.Pop()
+			dst[top] = top - i
+		}
+		stack.Push(i)
+	}
+	return dst
+}
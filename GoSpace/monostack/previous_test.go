@@ -0,0 +1,55 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPreviousWarmerDays(t *testing.T) {
+	tests := []struct {
+		num  []int
+		want []int
+	}{
+		{[]int{73, 74, 75, 71, 69, 72, 76, 73}, []int{0, 0, 0, 1, 1, 3, 0, 1}},
+		// Equal temperatures are not warmer.
+		{[]int{72, 70, 70, 72}, []int{0, 1, 2, 0}},
+	}
+	for _, tt := range tests {
+		if got := PreviousWarmerDays(tt.num); !slices.Equal(got, tt.want) {
+			t.Errorf("PreviousWarmerDays(%v) = %v, want %v", tt.num, got, tt.want)
+		}
+	}
+}
+
+func TestPreviousWarmerDaysMatchesReversed(t *testing.T) {
+	inputs := [][]int{
+		{},
+		{70},
+		{70, 70, 70, 70},
+		{73, 74, 75, 71, 69, 72, 76, 73},
+		{71, 71, 73, 73, 70, 70, 72, 72, 69},
+		{1, 2, 1, 2, 1, 2, 3, 3, 2, 1},
+	}
+	for _, num := range inputs {
+		rev := slices.Clone(num)
+		slices.Reverse(rev)
+		want := DailyTemperatures(rev)
+		slices.Reverse(want)
+		if got := PreviousWarmerDays(num); !slices.Equal(got, want) {
+			t.Errorf("PreviousWarmerDays(%v) = %v, want reversed DailyTemperatures %v", num, got, want)
+		}
+	}
+}
+
+func TestWarmerDaysBoth(t *testing.T) {
+	inputs := [][]int{nil, {70}, sampleWeek, {71, 71, 73, 73, 70, 70, 72, 72, 69}, randomTemps(100)}
+	for _, num := range inputs {
+		next, prev := WarmerDaysBoth(num)
+		if want := DailyTemperatures(num); !slices.Equal(next, want) {
+			t.Errorf("WarmerDaysBoth(%v) next = %v, want %v", num, next, want)
+		}
+		if want := PreviousWarmerDays(num); !slices.Equal(prev, want) {
+			t.Errorf("WarmerDaysBoth(%v) prev = %v, want %v", num, prev, want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package monostack
+
+// TrappingRainWater returns how many units of water are trapped between the
+// bars described by heights after it rains.
+//
+// The scan keeps a stack of bar indices with decreasing heights. A taller
+// bar pops the lower ones; each popped bar is the floor of a pool bounded by
+// the bar below it on the stack and the current bar, filled up to the lower
+// of those two walls.
+func TrappingRainWater(heights []int) int {
+	total := 0
+	stack := NewMonoStack[int](len(heights))
+	for i, h := range heights {
+		for !stack.Empty() && h > heights[stack.Peek()] {
+			floor := heights[stack.Pop()]
+			if stack.Empty() {
+				break
+			}
+			left := stack.Peek()
+			depth := min(heights[left], h) - floor
+			total += depth * (i - left - 1)
+		}
+		stack.Push(i)
+	}
+	return total
+}
@@ -0,0 +1,22 @@
+package monostack
+
+import "testing"
+
+func TestTrappingRainWater(t *testing.T) {
+	tests := []struct {
+		heights []int
+		want    int
+	}{
+		{nil, 0},
+		{[]int{0, 1, 0, 2, 1, 0, 1, 3, 2, 1, 2, 1}, 6},
+		{[]int{4, 2, 0, 3, 2, 5}, 9},
+		{[]int{2, 2, 2, 2}, 0},
+		{[]int{1, 2, 3, 4}, 0},
+		{[]int{4, 3, 2, 1}, 0},
+	}
+	for _, tt := range tests {
+		if got := TrappingRainWater(tt.heights); got != tt.want {
+			t.Errorf("TrappingRainWater(%v) = %d, want %d", tt.heights, got, tt.want)
+		}
+	}
+}
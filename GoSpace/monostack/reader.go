@@ -0,0 +1,81 @@
+package monostack
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// DailyTemperaturesFromReader parses integers separated by whitespace
+// and/or commas from r and returns their DailyTemperatures answer. Runs of
+// separators, including repeated commas, count as a single separator.
+//
+// Input is streamed token by token rather than read into memory first. A
+// token that is not an integer aborts parsing with an error wrapping
+// ErrMalformedInput that names the token, its 1-based position in the
+// stream, and its byte offset.
+func DailyTemperaturesFromReader(r io.Reader) ([]int, error) {
+	num, err := readTemps(r)
+	if err != nil {
+		return nil, err
+	}
+	return DailyTemperatures(num), nil
+}
+
+// readTemps parses the temperatures of DailyTemperaturesFromReader.
+func readTemps(r io.Reader) ([]int, error) {
+	var ts tokenSplitter
+	sc := bufio.NewScanner(r)
+	sc.Split(ts.split)
+	num := []int{}
+	for sc.Scan() {
+		v, err := strconv.Atoi(sc.Text())
+		if err != nil {
+			return nil, fmt.Errorf("%w: token %q (token %d, offset %d)", ErrMalformedInput, sc.Text(), len(num)+1, ts.start)
+		}
+		num = append(num, v)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return num, nil
+}
+
+// tokenSplitter is a bufio.SplitFunc that splits on whitespace and commas
+// while tracking the byte offset of the last token it returned.
+type tokenSplitter struct {
+	offset int // bytes consumed so far
+	start  int // offset of the last token
+}
+
+func (t *tokenSplitter) split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	begin := 0
+	for begin < len(data) && isSeparator(data[begin]) {
+		begin++
+	}
+	for i := begin; i < len(data); i++ {
+		if isSeparator(data[i]) {
+			return t.emit(begin, i+1, data[begin:i])
+		}
+	}
+	if atEOF && begin < len(data) {
+		return t.emit(begin, len(data), data[begin:])
+	}
+	t.offset += begin
+	return begin, nil, nil
+}
+
+func (t *tokenSplitter) emit(begin, advance int, token []byte) (int, []byte, error) {
+	t.start = t.offset + begin
+	t.offset += advance
+	return advance, token, nil
+}
+
+func isSeparator(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f', ',':
+		return true
+	}
+	return false
+}
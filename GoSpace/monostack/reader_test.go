@@ -0,0 +1,44 @@
+package monostack
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDailyTemperaturesFromReader(t *testing.T) {
+	want := DailyTemperatures(sampleWeek)
+	tests := []struct {
+		name  string
+		input string
+		want  []int
+	}{
+		{"comma", "73,74,75,71,69,72,76,73", want},
+		{"newline", "73\n74\n75\n71\n69\n72\n76\n73\n", want},
+		{"mixed", " 73,74 75\n71,, 69\t72\r\n76 ,73 ", want},
+		{"negative", "-5 -3 -4", []int{1, 0, 0}},
+		{"empty", "", []int{}},
+	}
+	for _, tt := range tests {
+		got, err := DailyTemperaturesFromReader(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: DailyTemperaturesFromReader(%q) = %v, want %v", tt.name, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDailyTemperaturesFromReaderMalformed(t *testing.T) {
+	_, err := DailyTemperaturesFromReader(strings.NewReader("73, 74 x7 1"))
+	if err == nil {
+		t.Fatal("DailyTemperaturesFromReader accepted a malformed token")
+	}
+	for _, part := range []string{`"x7"`, "token 3", "offset 7"} {
+		if !strings.Contains(err.Error(), part) {
+			t.Errorf("error %q does not mention %s", err, part)
+		}
+	}
+}
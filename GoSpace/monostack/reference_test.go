@@ -0,0 +1,38 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lqjxiaoqiu/lqjxiaoqiu/GoSpace/internal/reference"
+)
+
+// bruteForceDailyTemperatures is the O(n²) oracle the stack scans are
+// checked against.
+func bruteForceDailyTemperatures(num []int) []int {
+	return reference.DailyTemperatures(num)
+}
+
+func TestDailyTemperaturesMatchesReference(t *testing.T) {
+	tests := []struct {
+		name string
+		num  []int
+	}{
+		{"empty", []int{}},
+		{"single", []int{70}},
+		{"sample", sampleWeek},
+		{"all equal", []int{70, 70, 70, 70}},
+		{"increasing", []int{30, 40, 50, 60}},
+		{"decreasing", []int{60, 50, 40, 30}},
+		{"ties", []int{71, 71, 73, 73, 70, 70, 72, 72, 69}},
+		{"negative", []int{-5, -10, 0, -3, -3, 2}},
+		{"valley", []int{90, 30, 30, 30, 95}},
+		{"random", randomTemps(500)},
+	}
+	for _, tt := range tests {
+		got := DailyTemperatures(tt.num)
+		if want := bruteForceDailyTemperatures(tt.num); !slices.Equal(got, want) {
+			t.Errorf("%s: DailyTemperatures(%v) = %v, reference %v", tt.name, tt.num, got, want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package monostack
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// RenderTemperatures writes a table of num and its DailyTemperatures answer
+// to w, one day per line, for eyeballing results. For [73, 74, 75, 71]:
+//
+//	day  temp  wait
+//	  0    73  -> 1
+//	  1    74  -> 1
+//	  2    75  -
+//	  3    71  -
+//
+// The day and temperature columns are right-aligned to the widest entry; a
+// day with no warmer day ahead shows "-".
+func RenderTemperatures(num []int, w io.Writer) error {
+	ans := DailyTemperatures(num)
+	dayWidth := max(len("day"), len(strconv.Itoa(len(num)-1)))
+	tempWidth := len("temp")
+	for _, t := range num {
+		tempWidth = max(tempWidth, len(strconv.Itoa(t)))
+	}
+	if _, err := fmt.Fprintf(w, "%*s  %*s  %s\n", dayWidth, "day", tempWidth, "temp", "wait"); err != nil {
+		return err
+	}
+	for i, t := range num {
+		wait := "-"
+		if ans[i] > 0 {
+			wait = "-> " + strconv.Itoa(ans[i])
+		}
+		if _, err := fmt.Fprintf(w, "%*d  %*d  %s\n", dayWidth, i, tempWidth, t, wait); err != nil {
+			return err
+		}
+	}
+	return nil
+}
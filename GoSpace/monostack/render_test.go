@@ -0,0 +1,50 @@
+package monostack
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+func TestRenderTemperaturesGolden(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderTemperatures(sampleWeek, &buf); err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "render.golden")
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("RenderTemperatures(sampleWeek) =\n%s\nwant\n%s", buf.Bytes(), want)
+	}
+}
+
+func TestRenderTemperaturesWidths(t *testing.T) {
+	var buf bytes.Buffer
+	num := []int{-1000, 5, 6, 7, 1, 2, 3, 4, 0, 9, 8}
+	if err := RenderTemperatures(num, &buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.Split(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), []byte("\n"))
+	if len(lines) != len(num)+1 {
+		t.Fatalf("got %d lines, want %d", len(lines), len(num)+1)
+	}
+	// The wait column starts at the same offset on every line.
+	col := bytes.Index(lines[0], []byte("wait"))
+	for _, line := range lines[1:] {
+		if len(line) <= col || line[col-1] != ' ' || line[col] == ' ' {
+			t.Errorf("line %q is not aligned with the header", line)
+		}
+	}
+}
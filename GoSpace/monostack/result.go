@@ -0,0 +1,45 @@
+package monostack
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TemperatureResult pairs a series with its DailyTemperatures answer.
+type TemperatureResult struct {
+	Temps []int // the input readings
+	Waits []int // DailyTemperatures(Temps)
+}
+
+// AnalyzeTemperatures computes the DailyTemperatures answer of num and
+// returns it together with num.
+func AnalyzeTemperatures(num []int) TemperatureResult {
+	return TemperatureResult{Temps: num, Waits: DailyTemperatures(num)}
+}
+
+// NextWarmer returns the index of the next warmer day after day, and false
+// if there is none. It panics if day is out of range.
+func (r TemperatureResult) NextWarmer(day int) (int, bool) {
+	w := r.Waits[day]
+	if w == 0 {
+		return 0, false
+	}
+	return day + w, true
+}
+
+// String renders one line per day, such as "day 0: waits 1 day" or
+// "day 6: no warmer day".
+func (r TemperatureResult) String() string {
+	var b strings.Builder
+	for day, w := range r.Waits {
+		switch w {
+		case 0:
+			fmt.Fprintf(&b, "day %d: no warmer day\n", day)
+		case 1:
+			fmt.Fprintf(&b, "day %d: waits 1 day\n", day)
+		default:
+			fmt.Fprintf(&b, "day %d: waits %d days\n", day, w)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,29 @@
+package monostack
+
+import "testing"
+
+func TestTemperatureResultString(t *testing.T) {
+	r := AnalyzeTemperatures([]int{73, 74, 75, 71})
+	want := "day 0: waits 1 day\n" +
+		"day 1: waits 1 day\n" +
+		"day 2: no warmer day\n" +
+		"day 3: no warmer day\n"
+	if got := r.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+	if got := AnalyzeTemperatures([]int{70, 60, 80}).String(); got != "day 0: waits 2 days\nday 1: waits 1 day\nday 2: no warmer day\n" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestTemperatureResultNextWarmer(t *testing.T) {
+	r := AnalyzeTemperatures(sampleWeek)
+	if day, ok := r.NextWarmer(2); !ok || day != 6 {
+		t.Errorf("NextWarmer(2) = %d, %v; want 6, true", day, ok)
+	}
+	for _, d := range []int{6, 7} {
+		if _, ok := r.NextWarmer(d); ok {
+			t.Errorf("NextWarmer(%d) reported a warmer day", d)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package monostack
+
+import "iter"
+
+// DailyTemperaturesSeq returns an iterator over the DailyTemperatures answer
+// of num as (day, distance) pairs, in strictly increasing day order.
+//
+// The stack resolves days out of order, but every day below the bottom of
+// the stack is already resolved, so each day is yielded as soon as it and
+// all earlier days are known; days still pending when the scan ends are
+// yielded with distance 0. Only the answers from the bottom of the stack to
+// the current day are buffered, so mostly rising input needs little more
+// than the stack itself; a day that stays the warmest for a long stretch
+// holds everything after it, which in the worst case is O(n). Stopping the
+// range early also stops the scan.
+func DailyTemperaturesSeq(num []int) iter.Seq2[int, int] {
+	return func(yield func(day, distance int) bool) {
+		stack := getStack()
+		defer putStack(stack)
+		// buf[j] is the answer so far for day emitted+j.
+		var buf []int
+		emitted := 0
+		for i, v := range num {
+			for !stack.Empty() && v > num[stack.Peek()] {
+				top := stack.Pop()
+				buf[top-emitted] = i - top
+			}
+			limit := i
+			if !stack.Empty() {
+				limit = stack.items[0]
+			}
+			for ; emitted < limit; emitted++ {
+				if !yield(emitted, buf[0]) {
+					return
+				}
+				buf = buf[1:]
+			}
+			buf = append(buf, 0)
+			stack.Push(i)
+		}
+		for _, d := range buf {
+			if !yield(emitted, d) {
+				return
+			}
+			emitted++
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesSeq(t *testing.T) {
+	for _, num := range [][]int{nil, sampleWeek, {90, 80, 70, 100}, randomTemps(200)} {
+		got := []int{}
+		for day, dist := range DailyTemperaturesSeq(num) {
+			if day != len(got) {
+				t.Fatalf("DailyTemperaturesSeq(%v) yielded day %d after %d days", num, day, len(got))
+			}
+			got = append(got, dist)
+		}
+		if want := DailyTemperatures(num); !slices.Equal(got, want) {
+			t.Errorf("DailyTemperaturesSeq(%v) = %v, want %v", num, got, want)
+		}
+	}
+}
+
+func TestDailyTemperaturesSeqBreak(t *testing.T) {
+	var days []int
+	for day := range DailyTemperaturesSeq(sampleWeek) {
+		days = append(days, day)
+		if day == 2 {
+			break
+		}
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(days, want) {
+		t.Errorf("days before break = %v, want %v", days, want)
+	}
+}
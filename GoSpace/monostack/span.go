@@ -0,0 +1,26 @@
+package monostack
+
+// StockSpan returns, for each day, the number of consecutive days ending
+// today (today included) on which the price was less than or equal to
+// today's price. Ties extend the span: an earlier day with the same price
+// counts, and only a strictly higher price ends it.
+//
+// It is the backward-looking counterpart of DailyTemperatures: the stack
+// keeps days with strictly decreasing prices, and whatever remains below
+// today after popping is the previous strictly higher day.
+func StockSpan(prices []int) []int {
+	ans := make([]int, len(prices))
+	stack := NewMonoStack[int](len(prices))
+	for i, p := range prices {
+		for !stack.Empty() && prices[stack.Peek()] <= p {
+			stack.Pop()
+		}
+		prev := -1
+		if !stack.Empty() {
+			prev = stack.Peek()
+		}
+		ans[i] = i - prev
+		stack.Push(i)
+	}
+	return ans
+}
@@ -0,0 +1,23 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStockSpan(t *testing.T) {
+	tests := []struct {
+		prices []int
+		want   []int
+	}{
+		{nil, []int{}},
+		{[]int{100, 80, 60, 70, 60, 75, 85}, []int{1, 1, 1, 2, 1, 4, 6}},
+		// Equal prices extend the span.
+		{[]int{50, 50, 50}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		if got := StockSpan(tt.prices); !slices.Equal(got, tt.want) {
+			t.Errorf("StockSpan(%v) = %v, want %v", tt.prices, got, tt.want)
+		}
+	}
+}
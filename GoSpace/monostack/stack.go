@@ -0,0 +1,72 @@
+package monostack
+
+import "sync"
+
+// MonoStack is the stack behind the monotonic scans in this package. It
+// does not enforce an order itself; callers keep it monotonic by popping
+// before they push. Index-tracking scans such as DailyTemperatures store
+// indices, value-tracking scans store the values directly.
+//
+// The zero value is an empty stack ready to use.
+type MonoStack[T any] struct {
+	items []T
+}
+
+// NewMonoStack returns an empty stack with room for cap elements before it
+// needs to grow.
+func NewMonoStack[T any](cap int) *MonoStack[T] {
+	return &MonoStack[T]{items: make([]T, 0, cap)}
+}
+
+// Push puts v on top of the stack.
+func (s *MonoStack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the top element. It panics if the stack is empty.
+func (s *MonoStack[T]) Pop() T {
+	n := len(s.items) - 1
+	v := s.items[n]
+	s.items = s.items[:n]
+	return v
+}
+
+// Peek returns the top element without removing it. It panics if the stack
+// is empty.
+func (s *MonoStack[T]) Peek() T {
+	return s.items[len(s.items)-1]
+}
+
+// Len returns the number of elements on the stack.
+func (s *MonoStack[T]) Len() int {
+	return len(s.items)
+}
+
+// Empty reports whether the stack has no elements.
+func (s *MonoStack[T]) Empty() bool {
+	return len(s.items) == 0
+}
+
+// maxPooledStack caps the capacity of scratch stacks returned to stackPool
+// so one huge input does not pin its stack in memory indefinitely.
+const maxPooledStack = 1 << 16
+
+// stackPool holds scratch index stacks shared by concurrent scans.
+var stackPool = sync.Pool{
+	New: func() any { return new(MonoStack[int]) },
+}
+
+// getStack borrows an empty index stack from stackPool.
+func getStack() *MonoStack[int] {
+	s := stackPool.Get().(*MonoStack[int])
+	s.items = s.items[:0]
+	return s
+}
+
+// putStack returns s to stackPool unless it has grown too large to keep.
+func putStack(s *MonoStack[int]) {
+	if cap(s.items) > maxPooledStack {
+		return
+	}
+	stackPool.Put(s)
+}
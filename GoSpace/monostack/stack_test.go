@@ -0,0 +1,73 @@
+package monostack
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestMonoStackOrder(t *testing.T) {
+	var s MonoStack[string]
+	if !s.Empty() || s.Len() != 0 {
+		t.Fatalf("zero MonoStack: Empty() = %v, Len() = %d", s.Empty(), s.Len())
+	}
+	for _, v := range []string{"a", "b", "c"} {
+		s.Push(v)
+	}
+	if got := s.Peek(); got != "c" {
+		t.Errorf("Peek() = %q, want %q", got, "c")
+	}
+	for _, want := range []string{"c", "b", "a"} {
+		if got := s.Pop(); got != want {
+			t.Errorf("Pop() = %q, want %q", got, want)
+		}
+	}
+	if !s.Empty() {
+		t.Errorf("Empty() = false after popping everything, Len() = %d", s.Len())
+	}
+}
+
+func TestMonoStackGrowth(t *testing.T) {
+	s := NewMonoStack[int](2)
+	if got := cap(s.items); got != 2 {
+		t.Fatalf("NewMonoStack(2) capacity = %d, want 2", got)
+	}
+	const n = 100
+	for i := 0; i < n; i++ {
+		s.Push(i)
+	}
+	if s.Len() != n || cap(s.items) < n {
+		t.Fatalf("after %d pushes: Len() = %d, cap = %d", n, s.Len(), cap(s.items))
+	}
+	for i := n - 1; i >= 0; i-- {
+		if got := s.Pop(); got != i {
+			t.Fatalf("Pop() = %d, want %d", got, i)
+		}
+	}
+}
+
+// TestConcurrentPooledStacks runs many scans at once so that, under -race,
+// any sharing of pooled stacks between goroutines is reported.
+func TestConcurrentPooledStacks(t *testing.T) {
+	inputs := [][]int{sampleWeek, randomTemps(500), ascendingTemps(300), {90, 80, 70, 60}}
+	wants := make([][]int, len(inputs))
+	for i, num := range inputs {
+		wants[i] = DailyTemperatures(num)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 32; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				i := (g + k) % len(inputs)
+				if got := DailyTemperatures(inputs[i]); !slices.Equal(got, wants[i]) {
+					t.Errorf("goroutine %d: DailyTemperatures(inputs[%d]) mismatch", g, i)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,32 @@
+package monostack
+
+// Stats describes the stack work of one DailyTemperatures scan.
+type Stats struct {
+	MaxStackDepth int // most days pending at once
+	TotalPops     int // days resolved; never more than the input length
+}
+
+// DailyTemperaturesStats is DailyTemperatures also reporting how the stack
+// behaved. Nearly ascending input keeps MaxStackDepth small; long cooling
+// runs grow it. TotalPops <= len(num) always holds, which is what keeps the
+// scan linear.
+//
+// The scan cannot stop early once the stack empties: any later day may
+// still be the answer for days pushed after that point, so every reading is
+// visited.
+func DailyTemperaturesStats(num []int) ([]int, Stats) {
+	var st Stats
+	ans := make([]int, len(num))
+	stack := getStack()
+	defer putStack(stack)
+	for i, v := range num {
+		for !stack.Empty() && v > num[stack.Peek()] {
+			top := stack.Pop()
+			ans[top] = i - top
+			st.TotalPops++
+		}
+		stack.Push(i)
+		st.MaxStackDepth = max(st.MaxStackDepth, stack.Len())
+	}
+	return ans, st
+}
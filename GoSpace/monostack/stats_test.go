@@ -0,0 +1,32 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesStats(t *testing.T) {
+	ans, st := DailyTemperaturesStats(sampleWeek)
+	if want := DailyTemperatures(sampleWeek); !slices.Equal(ans, want) {
+		t.Errorf("answer = %v, want %v", ans, want)
+	}
+	// 75, 71, 69 are pending together before 72 arrives.
+	if want := (Stats{MaxStackDepth: 3, TotalPops: 6}); st != want {
+		t.Errorf("DailyTemperaturesStats(sampleWeek) stats = %+v, want %+v", st, want)
+	}
+
+	if _, st := DailyTemperaturesStats(ascendingTemps(50)); st.MaxStackDepth != 1 {
+		t.Errorf("ascending MaxStackDepth = %d, want 1", st.MaxStackDepth)
+	}
+	if _, st := DailyTemperaturesStats([]int{5, 4, 3, 2, 1}); st.MaxStackDepth != 5 || st.TotalPops != 0 {
+		t.Errorf("descending stats = %+v, want depth 5 and no pops", st)
+	}
+}
+
+func TestDailyTemperaturesStatsPopsBound(t *testing.T) {
+	for _, num := range [][]int{nil, sampleWeek, ascendingTemps(1000), randomTemps(1000), {70, 70, 70}} {
+		if _, st := DailyTemperaturesStats(num); st.TotalPops > len(num) {
+			t.Errorf("TotalPops = %d exceeds len %d", st.TotalPops, len(num))
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package monostack
+
+// LongestCoolingStreak returns the start and length of the longest run of
+// consecutive days in which every day is no warmer than the one before it,
+// so no warmer day occurs inside the run. Equal temperatures continue a
+// streak. When several streaks share the longest length the earliest is
+// returned; an empty num gives (0, 0) and every single day is a streak of
+// length 1.
+func LongestCoolingStreak(num []int) (start, length int) {
+	runStart := 0
+	for i := range num {
+		if i > 0 && num[i] > num[i-1] {
+			runStart = i
+		}
+		if n := i - runStart + 1; n > length {
+			start, length = runStart, n
+		}
+	}
+	return start, length
+}
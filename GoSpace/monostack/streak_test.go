@@ -0,0 +1,28 @@
+package monostack
+
+import "testing"
+
+func TestLongestCoolingStreak(t *testing.T) {
+	tests := []struct {
+		name          string
+		num           []int
+		start, length int
+	}{
+		{"empty", nil, 0, 0},
+		{"single", []int{70}, 0, 1},
+		{"rising", []int{1, 2, 3}, 0, 1},
+		// Three streaks of length 3; the earliest wins.
+		{"tie", []int{5, 4, 3, 9, 8, 7, 10, 6, 2}, 0, 3},
+		{"tie later", []int{1, 5, 4, 3, 9, 8, 7}, 1, 3},
+		// Equal temperatures continue a streak.
+		{"equal", []int{80, 72, 72, 72, 71, 75}, 0, 5},
+		{"longest last", []int{3, 2, 5, 5, 4, 4, 1}, 2, 5},
+	}
+	for _, tt := range tests {
+		start, length := LongestCoolingStreak(tt.num)
+		if start != tt.start || length != tt.length {
+			t.Errorf("%s: LongestCoolingStreak(%v) = (%d, %d), want (%d, %d)",
+				tt.name, tt.num, start, length, tt.start, tt.length)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package monostack
+
+// SumSubarrayMins returns the sum of min(sub) over every contiguous subarray
+// sub of arr. No modulo is applied, so the result overflows int once it
+// exceeds math.MaxInt; use SumSubarrayMinsMod for large inputs.
+func SumSubarrayMins(arr []int) int {
+	sum := 0
+	forEachMinSpan(arr, func(i, left, right int) {
+		sum += arr[i] * left * right
+	})
+	return sum
+}
+
+// SumSubarrayMinsMod is like SumSubarrayMins but reduces the sum modulo mod,
+// which must be positive. Intermediate products are reduced as well, so the
+// result is exact as long as (mod-1)*(mod-1) fits in an int.
+func SumSubarrayMinsMod(arr []int, mod int) int {
+	sum := 0
+	forEachMinSpan(arr, func(i, left, right int) {
+		v := ((arr[i]%mod + mod) % mod) * (left % mod) % mod
+		sum = (sum + v*(right%mod)) % mod
+	})
+	return sum
+}
+
+// forEachMinSpan calls f for every index i with the number of subarrays
+// ending at i (left) and starting at i (right) in which arr[i] is the
+// minimum. To count each subarray exactly once when values repeat, the left
+// boundary is the previous strictly smaller element and the right boundary
+// the next smaller-or-equal one, so among equal minimums the rightmost one
+// owns the subarray.
+//
+// Both boundaries come out of a single increasing-stack pass: popping an
+// index resolves its next smaller-or-equal element, and whatever is left
+// below a pushed index is its previous strictly smaller one.
+func forEachMinSpan(arr []int, f func(i, left, right int)) {
+	n := len(arr)
+	prev := make([]int, n)
+	next := make([]int, n)
+	stack := NewMonoStack[int](n)
+	for i, v := range arr {
+		next[i] = n
+		for !stack.Empty() && v <= arr[stack.Peek()] {
+			next[stack.Pop()] = i
+		}
+		prev[i] = -1
+		if !stack.Empty() {
+			prev[i] = stack.Peek()
+		}
+		stack.Push(i)
+	}
+	for i := range arr {
+		f(i, i-prev[i], next[i]-i)
+	}
+}
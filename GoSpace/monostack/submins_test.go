@@ -0,0 +1,68 @@
+package monostack
+
+import "testing"
+
+// bruteSumSubarrayMins sums the minimum of every subarray directly.
+func bruteSumSubarrayMins(arr []int) int {
+	sum := 0
+	for i := range arr {
+		m := arr[i]
+		for j := i; j < len(arr); j++ {
+			m = min(m, arr[j])
+			sum += m
+		}
+	}
+	return sum
+}
+
+func TestSumSubarrayMins(t *testing.T) {
+	tests := []struct {
+		arr  []int
+		want int
+	}{
+		{nil, 0},
+		{[]int{3, 1, 2, 4}, 17},
+		{[]int{11, 81, 94, 43, 3}, 444},
+		// Every one of the six subarrays has minimum 2; none may be
+		// counted twice.
+		{[]int{2, 2, 2}, 12},
+	}
+	for _, tt := range tests {
+		if got := SumSubarrayMins(tt.arr); got != tt.want {
+			t.Errorf("SumSubarrayMins(%v) = %d, want %d", tt.arr, got, tt.want)
+		}
+	}
+}
+
+func TestSumSubarrayMinsDuplicates(t *testing.T) {
+	inputs := [][]int{
+		{1, 1},
+		{3, 1, 3, 1, 3},
+		{2, 2, 1, 1, 2, 2},
+		{5, 4, 4, 5, 4, 6, 4},
+	}
+	for _, arr := range inputs {
+		if got, want := SumSubarrayMins(arr), bruteSumSubarrayMins(arr); got != want {
+			t.Errorf("SumSubarrayMins(%v) = %d, want %d", arr, got, want)
+		}
+	}
+}
+
+func TestSumSubarrayMinsMod(t *testing.T) {
+	const mod = 1_000_000_007
+	tests := []struct {
+		arr  []int
+		mod  int
+		want int
+	}{
+		{[]int{3, 1, 2, 4}, 5, 17 % 5},
+		{[]int{11, 81, 94, 43, 3}, mod, 444},
+		{[]int{-3, 1, -2}, 7, ((bruteSumSubarrayMins([]int{-3, 1, -2}) % 7) + 7) % 7},
+		{[]int{mod - 1, mod - 1, mod - 1}, mod, 6 * (mod - 1) % mod},
+	}
+	for _, tt := range tests {
+		if got := SumSubarrayMinsMod(tt.arr, tt.mod); got != tt.want {
+			t.Errorf("SumSubarrayMinsMod(%v, %d) = %d, want %d", tt.arr, tt.mod, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package monostack
+
+import (
+	"container/heap"
+	"math"
+)
+
+// DailyTemperaturesThreshold is like DailyTemperatures but a day i only
+// resolves on a later day that is strictly warmer by at least pct percent
+// of |num[i]|; with pct = 0 it matches DailyTemperatures. A negative pct is
+// treated as 0.
+//
+// A percentage threshold breaks the single monotonic stack: a colder day
+// can need a larger rise than a warmer one below it, so pending days no
+// longer resolve in stack order. Instead they wait in a min-heap keyed on
+// the temperature that resolves them, and each reading pops every day whose
+// threshold it meets. Every day is pushed and popped once, for O(n log n)
+// time and O(n) space.
+func DailyTemperaturesThreshold(num []int, pct float64) []int {
+	pct = math.Max(pct, 0)
+	ans := make([]int, len(num))
+	var h thresholdHeap
+	for i, t := range num {
+		v := float64(t)
+		for len(h) > 0 && h[0].resolvedBy(v) {
+			top := heap.Pop(&h).(thresholdDay)
+			ans[top.day] = i - top.day
+		}
+		heap.Push(&h, thresholdDay{
+			day:    i,
+			temp:   v,
+			target: v + pct/100*math.Abs(v),
+		})
+	}
+	return ans
+}
+
+// thresholdDay is a day waiting in DailyTemperaturesThreshold.
+type thresholdDay struct {
+	day    int
+	temp   float64
+	target float64 // lowest later temperature that resolves the day
+}
+
+// resolvedBy reports whether a later reading v resolves d.
+func (d thresholdDay) resolvedBy(v float64) bool {
+	return v >= d.target && v > d.temp
+}
+
+// thresholdHeap orders pending days by target, then temp. When a reading
+// equals a target, the days it resolves (temp below the reading) therefore
+// come before those it does not (temp equal to it), so popping can stop at
+// the first day that is not resolved.
+type thresholdHeap []thresholdDay
+
+func (h thresholdHeap) Len() int { return len(h) }
+
+func (h thresholdHeap) Less(i, j int) bool {
+	if h[i].target != h[j].target {
+		return h[i].target < h[j].target
+	}
+	return h[i].temp < h[j].temp
+}
+
+func (h thresholdHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *thresholdHeap) Push(x any) { *h = append(*h, x.(thresholdDay)) }
+
+func (h *thresholdHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,57 @@
+package monostack
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestDailyTemperaturesThresholdZero(t *testing.T) {
+	for _, num := range [][]int{nil, sampleWeek, {70, 70, 71}, {-5, -10, 0, -3}, randomTemps(300)} {
+		if got, want := DailyTemperaturesThreshold(num, 0), DailyTemperatures(num); !slices.Equal(got, want) {
+			t.Errorf("DailyTemperaturesThreshold(%v, 0) = %v, want %v", num, got, want)
+		}
+	}
+}
+
+func TestDailyTemperaturesThreshold(t *testing.T) {
+	tests := []struct {
+		num  []int
+		pct  float64
+		want []int
+	}{
+		{[]int{100, 101, 102, 103, 104, 105, 106}, 5, []int{5, 0, 0, 0, 0, 0, 0}},
+		{[]int{100, 101, 102, 103, 104}, 2, []int{2, 3, 0, 0, 0}},
+		// Day 1 needs only 2 degrees, day 0 needs 10: the colder, later
+		// day resolves first.
+		{[]int{100, 20, 23, 110}, 10, []int{3, 1, 1, 0}},
+		{[]int{100, 101}, -5, []int{1, 0}},
+	}
+	for _, tt := range tests {
+		if got := DailyTemperaturesThreshold(tt.num, tt.pct); !slices.Equal(got, tt.want) {
+			t.Errorf("DailyTemperaturesThreshold(%v, %v) = %v, want %v", tt.num, tt.pct, got, tt.want)
+		}
+	}
+}
+
+func TestDailyTemperaturesThresholdBrute(t *testing.T) {
+	num := randomTemps(300)
+	for i := range num {
+		num[i] -= 50 // mix in negative readings
+	}
+	for _, pct := range []float64{1, 10, 50} {
+		got := DailyTemperaturesThreshold(num, pct)
+		for i, v := range num {
+			want := 0
+			for j := i + 1; j < len(num); j++ {
+				if num[j] > v && float64(num[j]) >= float64(v)+pct/100*math.Abs(float64(v)) {
+					want = j - i
+					break
+				}
+			}
+			if got[i] != want {
+				t.Fatalf("pct %v: day %d = %d, want %d", pct, i, got[i], want)
+			}
+		}
+	}
+}
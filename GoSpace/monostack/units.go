@@ -0,0 +1,64 @@
+package monostack
+
+import (
+	"math"
+	"strconv"
+)
+
+// Unit is a temperature scale.
+type Unit int
+
+const (
+	Celsius    Unit = iota // degrees Celsius; the zero Unit
+	Fahrenheit             // degrees Fahrenheit
+)
+
+// String returns the unit's symbol, such as "°C".
+func (u Unit) String() string {
+	switch u {
+	case Celsius:
+		return "°C"
+	case Fahrenheit:
+		return "°F"
+	}
+	return "Unit(" + strconv.Itoa(int(u)) + ")"
+}
+
+// Reading is a temperature tagged with its scale. The zero Unit is Celsius.
+type Reading struct {
+	Value float64
+	Unit  Unit
+}
+
+// Convert converts v from one scale to another. An unknown unit on either
+// side yields NaN.
+func Convert(v float64, from, to Unit) float64 {
+	var c float64
+	switch from {
+	case Celsius:
+		c = v
+	case Fahrenheit:
+		c = (v - 32) * 5 / 9
+	default:
+		return math.NaN()
+	}
+	switch to {
+	case Celsius:
+		return c
+	case Fahrenheit:
+		return c*9/5 + 32
+	}
+	return math.NaN()
+}
+
+// DailyTemperaturesReadings is DailyTemperatures for readings in mixed
+// units: every reading is converted to Celsius before the scan, so 70°F
+// (about 21.1°C) counts as warmer than 21°C. A reading with an unknown unit
+// converts to NaN and is handled as in DailyTemperaturesFloat.
+func DailyTemperaturesReadings(rs []Reading) []int {
+	num := make([]float64, len(rs))
+	for i, r := range rs {
+		num[i] = Convert(r.Value, r.Unit, Celsius)
+	}
+	return DailyTemperaturesFloat(num)
+}
@@ -0,0 +1,46 @@
+package monostack
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		v        float64
+		from, to Unit
+		want     float64
+	}{
+		{0, Celsius, Fahrenheit, 32},
+		{100, Celsius, Fahrenheit, 212},
+		{-40, Fahrenheit, Celsius, -40},
+		{77, Fahrenheit, Celsius, 25},
+		{21.5, Celsius, Celsius, 21.5},
+	}
+	for _, tt := range tests {
+		if got := Convert(tt.v, tt.from, tt.to); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Convert(%v, %v, %v) = %v, want %v", tt.v, tt.from, tt.to, got, tt.want)
+		}
+	}
+	if got := Convert(1, Unit(9), Celsius); !math.IsNaN(got) {
+		t.Errorf("Convert with an unknown unit = %v, want NaN", got)
+	}
+}
+
+func TestDailyTemperaturesReadings(t *testing.T) {
+	rs := []Reading{
+		{Value: 25, Unit: Celsius},    // 77°F
+		{Value: 70, Unit: Fahrenheit}, // about 21.1°C: colder, despite 70 > 25
+		{Value: 80, Unit: Fahrenheit}, // about 26.7°C
+		{Value: 26, Unit: Celsius},
+	}
+	got := DailyTemperaturesReadings(rs)
+	if want := []int{2, 1, 0, 0}; !slices.Equal(got, want) {
+		t.Errorf("DailyTemperaturesReadings = %v, want %v", got, want)
+	}
+	raw := DailyTemperatures([]int{25, 70, 80, 26})
+	if slices.Equal(got, raw) {
+		t.Errorf("unit-aware answer %v should differ from raw comparison %v", got, raw)
+	}
+}
@@ -0,0 +1,28 @@
+package monostack
+
+import "math"
+
+// NoWarmerValue is the sentinel NextWarmerValue reports for days with no
+// warmer day ahead. It is math.MinInt so it cannot be confused with a real
+// negative temperature.
+const NoWarmerValue = math.MinInt
+
+// NextWarmerValue returns, for each day, the temperature of the next
+// strictly warmer day, or NoWarmerValue if there is none.
+func NextWarmerValue(num []int) []int {
+	return NextWarmerValueOr(num, NoWarmerValue)
+}
+
+// NextWarmerValueOr is like NextWarmerValue but reports none for days with
+// no warmer day ahead.
+func NextWarmerValueOr(num []int, none int) []int {
+	ans := NextGreaterFunc(num, warmer[int])
+	for i, next := range ans {
+		if next < 0 {
+			ans[i] = none
+		} else {
+			ans[i] = num[next]
+		}
+	}
+	return ans
+}
@@ -0,0 +1,33 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+var sampleWeek = []int{73, 74, 75, 71, 69, 72, 76, 73}
+
+func TestNextWarmerValue(t *testing.T) {
+	got := NextWarmerValue(sampleWeek)
+	want := []int{74, 75, 76, 72, 72, 76, NoWarmerValue, NoWarmerValue}
+	if !slices.Equal(got, want) {
+		t.Errorf("NextWarmerValue(%v) = %v, want %v", sampleWeek, got, want)
+	}
+}
+
+func TestNextWarmerValueNegative(t *testing.T) {
+	// -1 is a real reading here, so only the last day is unresolved.
+	num := []int{-5, -10, -1}
+	if got, want := NextWarmerValue(num), []int{-1, -1, NoWarmerValue}; !slices.Equal(got, want) {
+		t.Errorf("NextWarmerValue(%v) = %v, want %v", num, got, want)
+	}
+	if got, want := NextWarmerValueOr(num, 999), []int{-1, -1, 999}; !slices.Equal(got, want) {
+		t.Errorf("NextWarmerValueOr(%v, 999) = %v, want %v", num, got, want)
+	}
+}
+
+func TestNextWarmerValueEmpty(t *testing.T) {
+	if got := NextWarmerValue([]int{}); got == nil || len(got) != 0 {
+		t.Errorf("NextWarmerValue([]int{}) = %#v, want non-nil empty slice", got)
+	}
+}
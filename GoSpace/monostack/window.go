@@ -0,0 +1,30 @@
+package monostack
+
+import "fmt"
+
+// SlidingWindowMax returns the maximum of every window of k consecutive
+// elements of nums, in window order, so the result has len(nums)-k+1
+// elements. It returns an error wrapping ErrInvalidWindow if k < 1 or
+// k > len(nums).
+//
+// The window is kept as a MonoDeque with decreasing values: a new element
+// evicts every smaller one from the back, the front is dropped once it
+// slides out of the window, and the front is always the window maximum.
+// Each index enters and leaves the deque once, so the scan is O(n).
+func SlidingWindowMax(nums []int, k int) ([]int, error) {
+	if k < 1 || k > len(nums) {
+		return nil, fmt.Errorf("%w: %d not in [1, %d]", ErrInvalidWindow, k, len(nums))
+	}
+	ans := make([]int, 0, len(nums)-k+1)
+	var deque MonoDeque[int]
+	for i, v := range nums {
+		deque.PushKeepingDecreasing(v)
+		if deque.Front().Index <= i-k {
+			deque.PopFront()
+		}
+		if i >= k-1 {
+			ans = append(ans, deque.Front().Value)
+		}
+	}
+	return ans, nil
+}
@@ -0,0 +1,37 @@
+package monostack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSlidingWindowMax(t *testing.T) {
+	tests := []struct {
+		nums []int
+		k    int
+		want []int
+	}{
+		{[]int{1, 3, -1, -3, 5, 3, 6, 7}, 3, []int{3, 3, 5, 5, 6, 7}},
+		{[]int{4, 2, 12, 3}, 1, []int{4, 2, 12, 3}},
+		{[]int{4, 2, 12, 3}, 4, []int{12}},
+		{[]int{2, 2, 1, 2}, 2, []int{2, 2, 2}},
+	}
+	for _, tt := range tests {
+		got, err := SlidingWindowMax(tt.nums, tt.k)
+		if err != nil {
+			t.Errorf("SlidingWindowMax(%v, %d): unexpected error %v", tt.nums, tt.k, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("SlidingWindowMax(%v, %d) = %v, want %v", tt.nums, tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestSlidingWindowMaxInvalid(t *testing.T) {
+	for _, k := range []int{0, -1, 4} {
+		if got, err := SlidingWindowMax([]int{1, 2, 3}, k); err == nil || got != nil {
+			t.Errorf("SlidingWindowMax([1 2 3], %d) = %v, %v; want nil and an error", k, got, err)
+		}
+	}
+}